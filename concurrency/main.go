@@ -33,11 +33,11 @@ func getRandomServer() string {
 	return servers[index]
 }
 
-func worker(wg *sync.WaitGroup, jobs <-chan float64, results chan<- error) {
+func worker(wg *sync.WaitGroup, walletID string, jobs <-chan float64, results chan<- error) {
 	defer wg.Done()
 	for amount := range jobs {
 		txID := uuid.New().String()
-		statusCode, err := createTx(txID, amount, getRandomServer())
+		statusCode, err := createTx(txID, walletID, amount, getRandomServer())
 		if statusCode != 202 {
 			fmt.Println("Error creating transaction. Status code:"+strconv.Itoa(statusCode), err)
 		}
@@ -53,7 +53,7 @@ func main() {
 	}
 	defer db.Close()
 
-	initialID := "0f31adad-bfb6-41d1-aeff-c110ca13cbfa"
+	walletID := "0f31adad-bfb6-41d1-aeff-c110ca13cbfa"
 
 	tx, err := db.Begin()
 	if err != nil {
@@ -61,13 +61,13 @@ func main() {
 	}
 
 	var initialBalance float64
-	err = tx.QueryRow("SELECT value FROM balances WHERE id = $1", initialID).Scan(&initialBalance)
+	err = tx.QueryRow("SELECT value FROM balances WHERE wallet_id = $1", walletID).Scan(&initialBalance)
 
 	if err == sql.ErrNoRows {
 		_, err = tx.Exec(`
-			INSERT INTO balances (id, value)
-			VALUES ($1, $2)
-		`, initialID, 100000)
+			INSERT INTO balances (id, wallet_id, value)
+			VALUES ($1, $2, $3)
+		`, uuid.New(), walletID, 100000)
 		initialBalance = 100000
 		if err != nil {
 			tx.Rollback()
@@ -87,7 +87,7 @@ func main() {
 
 	for i := 0; i < numWorkers; i++ {
 		wg.Add(1)
-		go worker(&wg, jobs, results)
+		go worker(&wg, walletID, jobs, results)
 	}
 
 	rand.Seed(time.Now().UnixNano())
@@ -117,7 +117,7 @@ func main() {
 	time.Sleep(5 * time.Second)
 
 	var finalBalance float64
-	err = db.QueryRow("SELECT value FROM balances WHERE id=$1", initialID).Scan(&finalBalance)
+	err = db.QueryRow("SELECT value FROM balances WHERE wallet_id=$1", walletID).Scan(&finalBalance)
 	if err != nil {
 		log.Fatalf("Failed to retrieve final balance: %v", err)
 	}
@@ -125,7 +125,7 @@ func main() {
 	fmt.Printf("Final Balance in Database: %.2f\n", finalBalance)
 }
 
-func createTx(txID string, amount float64, host string) (int, error) {
+func createTx(txID string, walletID string, amount float64, host string) (int, error) {
 	action := "win"
 	if amount < 0 {
 		action = "lost"
@@ -135,6 +135,7 @@ func createTx(txID string, amount float64, host string) (int, error) {
 		"state":         action,
 		"amount":        fmt.Sprintf("%.2f", amount),
 		"transactionId": txID,
+		"walletId":      walletID,
 	}
 
 	jsonPayload, err := json.Marshal(payload)
@@ -5,7 +5,8 @@ import (
 	"os"
 )
 
-// Load initializes Viper to automatically read environment variables and sets default database configuration values.
+// Load initializes Viper to automatically read environment variables and sets default database and service
+// configuration values.
 func Load() {
 	viper.AutomaticEnv()
 
@@ -14,6 +15,32 @@ func Load() {
 	viper.Set("db.dbname", getEnv("DB_NAME", "txdb"))
 	viper.Set("db.host", getEnv("DB_HOST", "db"))
 	viper.Set("db.port", getEnv("DB_PORT", "5432"))
+	viper.Set("db.migrate_on_start", getEnv("DB_MIGRATE_ON_START", "true"))
+
+	viper.Set("balance.batch_size", getEnv("BALANCE_BATCH_SIZE", "100"))
+
+	viper.Set("worker.poll_interval", getEnv("WORKER_POLL_INTERVAL", "100ms"))
+	viper.Set("worker.max_poll_interval", getEnv("WORKER_MAX_POLL_INTERVAL", "5s"))
+	viper.Set("worker.restart_delay", getEnv("WORKER_RESTART_DELAY", "1s"))
+	viper.Set("worker.max_restart_delay", getEnv("WORKER_MAX_RESTART_DELAY", "1m"))
+
+	viper.Set("sync.timeout", getEnv("SYNC_TIMEOUT", "5s"))
+	viper.Set("sync.poll_interval", getEnv("SYNC_POLL_INTERVAL", "50ms"))
+
+	viper.Set("correction.interval", getEnv("CORRECTION_INTERVAL", "10m"))
+	viper.Set("correction.batch_size", getEnv("CORRECTION_BATCH_SIZE", "10"))
+	viper.Set("correction.strategy", getEnv("CORRECTION_STRATEGY", "odd"))
+	viper.Set("correction.source_type", getEnv("CORRECTION_SOURCE_TYPE", ""))
+	viper.Set("correction.transaction_ids", getEnv("CORRECTION_TRANSACTION_IDS", ""))
+
+	viper.Set("snapshot.interval", getEnv("SNAPSHOT_INTERVAL", "1h"))
+
+	viper.Set("reconciliation.interval", getEnv("RECONCILIATION_INTERVAL", "1h"))
+	viper.Set("reconciliation.auto_repair", getEnv("RECONCILIATION_AUTO_REPAIR", "false"))
+
+	viper.Set("tracing.exporter", getEnv("TRACING_EXPORTER", "none"))
+	viper.Set("tracing.otlp_endpoint", getEnv("TRACING_OTLP_ENDPOINT", "localhost:4318"))
+	viper.Set("tracing.max_sampling_rate", getEnv("TRACING_MAX_SAMPLING_RATE", "10"))
 }
 
 func getEnv(key, defaultValue string) string {
@@ -15,11 +15,102 @@ var _ = API("Wallet", func() {
 	})
 })
 
+var Transaction = Type("Transaction", func() {
+	Description("Transaction and its processing status")
+
+	Attribute("id", String, "Internal ID of the transaction", func() {
+		Format(FormatUUID)
+		Example("5a1a2f5e-4c1b-4d0e-9d55-2f8b7f0f6a11")
+	})
+	Attribute("transactionId", String, "Transaction ID given by the source, unique within the source type", func() {
+		Example("some generated identificator")
+	})
+	Attribute("walletId", String, "Wallet ID", func() {
+		Example("0f31adad-bfb6-41d1-aeff-c110ca13cbfa")
+	})
+	Attribute("status", String, "Processing status of the transaction", func() {
+		Enum("new", "locked", "done", "cancelled")
+		Example("done")
+	})
+	Attribute("amount", String, "Amount of the transaction", func() {
+		Example("10.15")
+	})
+	Attribute("currency", String, "ISO 4217 currency code of the amount", func() {
+		Example("EUR")
+	})
+	Attribute("action", String, "Action of the transaction", func() {
+		Enum("win", "lost")
+		Example("win")
+	})
+	Attribute("sourceType", String, "Source type of the transaction", func() {
+		Enum("game", "server", "payment", "internal")
+		Example("game")
+	})
+	Attribute("cancelReason", String, "Reason the transaction was cancelled for", func() {
+		Enum("insufficient_funds", "currency_mismatch", "reversed")
+		Example("insufficient_funds")
+	})
+	Attribute("reversedBy", String, "Internal ID of the compensating transaction which reversed the cancelled transaction", func() {
+		Format(FormatUUID)
+	})
+	Attribute("reverses", ArrayOf(String), "Internal IDs of the transactions reversed by the compensating transaction", func() {
+		Elem(func() {
+			Format(FormatUUID)
+		})
+	})
+	Attribute("processedAt", String, "Time the transaction was done or cancelled by the balance worker, balances as of this time or later include a done transaction", func() {
+		Format(FormatDateTime)
+	})
+	Attribute("createdAt", String, "Creation time", func() {
+		Format(FormatDateTime)
+	})
+	Attribute("updatedAt", String, "Last update time", func() {
+		Format(FormatDateTime)
+	})
+	Required("id", "transactionId", "walletId", "status", "amount", "currency", "action", "sourceType", "createdAt", "updatedAt")
+})
+
+var TransactionList = Type("TransactionList", func() {
+	Description("Page of transactions")
+
+	Attribute("transactions", ArrayOf(Transaction), "Transactions ordered from the newest to the oldest")
+	Attribute("nextCursor", String, "Cursor of the next page, absent on the last page")
+	Required("transactions")
+})
+
+var WorkerHealth = Type("WorkerHealth", func() {
+	Description("State of a background worker")
+
+	Attribute("name", String, "Name of the worker", func() {
+		Example("balance")
+	})
+	Attribute("status", String, "Status of the worker", func() {
+		Enum("running", "restarting", "stopped", "failed")
+		Example("running")
+	})
+	Attribute("crashes", Int64, "Number of rounds which failed with an error or a panic since the start", func() {
+		Example(0)
+	})
+	Attribute("lastError", String, "Error of the last failed round")
+	Required("name", "status", "crashes")
+})
+
 var _ = Service("transaction", func() {
 	Description("The transaction service")
 
+	Error("unavailable", ErrorResult, "Storage is temporarily unavailable", func() {
+		Temporary()
+	})
+	Error("invalid_input", ErrorResult, "Identifier or date is malformed")
+
 	HTTP(func() {
 		Path("/transaction")
+		Response("unavailable", StatusServiceUnavailable, func() {
+			Description("Storage is temporarily unavailable")
+		})
+		Response("invalid_input", StatusBadRequest, func() {
+			Description("Identifier or date is malformed")
+		})
 	})
 
 	// Healthcheck Method
@@ -35,7 +126,10 @@ var _ = Service("transaction", func() {
 		})
 
 		Result(func() {
-			Attribute("status", String, "Service status")
+			Attribute("status", String, "Service status, degraded if a worker has failed", func() {
+				Enum("ok", "degraded")
+			})
+			Attribute("workers", ArrayOf(WorkerHealth), "Background workers of the instance")
 			Required("status")
 		})
 	})
@@ -52,22 +146,87 @@ var _ = Service("transaction", func() {
 			Attribute("amount", String, "Amount of the transaction", func() {
 				Example("10.15")
 			})
+			Attribute("currency", String, "ISO 4217 currency code of the amount, must match the wallet currency", func() {
+				Pattern("^[A-Z]{3}$")
+				Default("EUR")
+				Example("EUR")
+			})
 			Attribute("transactionId", String, "Transaction ID", func() {
 				Example("some generated identificator")
 			})
+			Attribute("walletId", String, "Wallet ID", func() {
+				Format(FormatUUID)
+				Example("0f31adad-bfb6-41d1-aeff-c110ca13cbfa")
+			})
 			Attribute("sourceType", String, "Source type header", func() {
 				Enum("game", "server", "payment")
 				Example("game")
 			})
-			Required("state", "amount", "transactionId", "sourceType")
+			Attribute("wait", Boolean, "Wait until the transaction is processed", func() {
+				Default(false)
+			})
+			Required("state", "amount", "transactionId", "walletId", "sourceType")
 		})
 
-		Result(Empty)
+		Result(func() {
+			Attribute("outcome", String, "Outcome of the request", func() {
+				Enum("accepted", "processed", "replayed")
+			})
+			Attribute("balance", String, "Balance of the wallet after the transaction was processed", func() {
+				Example("10.15")
+			})
+			Required("outcome")
+		})
+
+		Error("invalid_amount", ErrorResult, "Amount is not a decimal number with the currency precision or is too large")
+		Error("state_amount_mismatch", ErrorResult, "Amount sign does not match the state, win amounts must be positive and lost amounts negative")
+		Error("unsupported_currency", ErrorResult, "Currency is not supported")
+		Error("currency_mismatch", ErrorResult, "Currency differs from the wallet currency")
+		Error("duplicate_transaction", ErrorResult, "Transaction with the same ID but a different payload already exists")
+		Error("insufficient_funds", ErrorResult, "Transaction was cancelled because of insufficient funds")
+		Error("reversed", ErrorResult, "Transaction was processed and then cancelled by a correction")
 
 		HTTP(func() {
 			POST("/")
 			Header("sourceType:Source-Type")
-			Response(StatusAccepted)
+			Header("wait:X-Wait")
+			Response(StatusCreated, func() {
+				Description("Transaction processed")
+				Tag("outcome", "processed")
+				Body(func() {
+					Attribute("balance")
+				})
+			})
+			Response(StatusOK, func() {
+				Description("Transaction with the same ID and payload already exists")
+				Tag("outcome", "replayed")
+				Body(Empty)
+			})
+			Response(StatusAccepted, func() {
+				Description("Transaction accepted")
+				Body(Empty)
+			})
+			Response("invalid_amount", StatusBadRequest, func() {
+				Description("Invalid amount")
+			})
+			Response("state_amount_mismatch", StatusBadRequest, func() {
+				Description("Amount sign does not match the state")
+			})
+			Response("unsupported_currency", StatusBadRequest, func() {
+				Description("Unsupported currency")
+			})
+			Response("currency_mismatch", StatusConflict, func() {
+				Description("Currency differs from the wallet currency")
+			})
+			Response("duplicate_transaction", StatusConflict, func() {
+				Description("Transaction with the same ID but a different payload already exists")
+			})
+			Response("insufficient_funds", StatusConflict, func() {
+				Description("Transaction was cancelled because of insufficient funds")
+			})
+			Response("reversed", StatusConflict, func() {
+				Description("Transaction was processed and then cancelled by a correction")
+			})
 			Response(StatusBadRequest, func() {
 				Description("Invalid input")
 			})
@@ -76,4 +235,326 @@ var _ = Service("transaction", func() {
 			})
 		})
 	})
+	// Balance retrieving method
+	Method("balance", func() {
+		Description("Retrieve the current balance of a wallet or its balance at a point in time")
+
+		Payload(func() {
+			Attribute("walletId", String, "Wallet ID", func() {
+				Format(FormatUUID)
+				Example("0f31adad-bfb6-41d1-aeff-c110ca13cbfa")
+			})
+			Attribute("asOf", String, "Return the balance made up of the transactions processed at or before this time", func() {
+				Format(FormatDateTime)
+				Example("2024-07-20T18:00:00Z")
+			})
+			Required("walletId")
+		})
+
+		Result(func() {
+			Attribute("walletId", String, "Wallet ID", func() {
+				Example("0f31adad-bfb6-41d1-aeff-c110ca13cbfa")
+			})
+			Attribute("amount", String, "Balance of the wallet", func() {
+				Example("10.15")
+			})
+			Attribute("currency", String, "ISO 4217 currency code of the wallet", func() {
+				Example("EUR")
+			})
+			Attribute("pending", Int, "Number of transactions in new or locked status which are not included into the balance yet", func() {
+				Example(0)
+			})
+			Attribute("asOf", String, "Point in time of the balance, not set for the current balance", func() {
+				Format(FormatDateTime)
+			})
+			Required("walletId", "amount", "currency", "pending")
+		})
+
+		HTTP(func() {
+			GET("/balance/{walletId}")
+			Param("asOf:as_of")
+			Response(StatusOK, func() {
+				Description("Current balance")
+				ContentType("application/json")
+			})
+			Response(StatusBadRequest, func() {
+				Description("Invalid input")
+			})
+			Response(StatusInternalServerError, func() {
+				Description("Internal server error")
+			})
+		})
+	})
+	// Transaction lookup method
+	Method("show", func() {
+		Description("Retrieve the transaction and its processing status")
+
+		Payload(func() {
+			Attribute("transactionId", String, "Transaction ID given by the source", func() {
+				Example("some generated identificator")
+			})
+			Attribute("sourceType", String, "Source type header", func() {
+				Enum("game", "server", "payment", "internal")
+				Example("game")
+			})
+			Required("transactionId", "sourceType")
+		})
+
+		Result(Transaction)
+
+		Error("not_found", ErrorResult, "Transaction not found")
+
+		HTTP(func() {
+			GET("/{transactionId}")
+			Header("sourceType:Source-Type")
+			Response(StatusOK, func() {
+				Description("Transaction")
+				ContentType("application/json")
+			})
+			Response("not_found", StatusNotFound, func() {
+				Description("Transaction not found")
+			})
+			Response(StatusInternalServerError, func() {
+				Description("Internal server error")
+			})
+		})
+	})
+	// Transaction history method
+	Method("list", func() {
+		Description("List transactions page by page, from the newest to the oldest")
+
+		Payload(func() {
+			Attribute("walletId", String, "Wallet ID", func() {
+				Format(FormatUUID)
+				Example("0f31adad-bfb6-41d1-aeff-c110ca13cbfa")
+			})
+			Attribute("status", String, "Processing status of the transaction", func() {
+				Enum("new", "locked", "done", "cancelled")
+			})
+			Attribute("action", String, "Action of the transaction", func() {
+				Enum("win", "lost")
+			})
+			Attribute("sourceType", String, "Source type of the transaction", func() {
+				Enum("game", "server", "payment", "internal")
+			})
+			Attribute("from", String, "Include transactions created at or after this time", func() {
+				Format(FormatDateTime)
+			})
+			Attribute("to", String, "Include transactions created before this time", func() {
+				Format(FormatDateTime)
+			})
+			Attribute("cursor", String, "Cursor returned with the previous page")
+			Attribute("limit", Int, "Maximum number of transactions in the page", func() {
+				Minimum(1)
+				Maximum(500)
+				Default(50)
+			})
+		})
+
+		Result(TransactionList)
+
+		Error("invalid_cursor", ErrorResult, "Cursor cannot be decoded")
+
+		HTTP(func() {
+			GET("/")
+			Param("walletId")
+			Param("status")
+			Param("action")
+			Param("sourceType")
+			Param("from")
+			Param("to")
+			Param("cursor")
+			Param("limit")
+			Response(StatusOK, func() {
+				Description("Page of transactions")
+				ContentType("application/json")
+			})
+			Response("invalid_cursor", StatusBadRequest, func() {
+				Description("Cursor cannot be decoded")
+			})
+			Response(StatusInternalServerError, func() {
+				Description("Internal server error")
+			})
+		})
+	})
+})
+
+var ManualCorrection = Type("ManualCorrection", func() {
+	Description("Manual correction requested by a support agent")
+
+	Attribute("id", String, "ID of the correction", func() {
+		Format(FormatUUID)
+	})
+	Attribute("walletId", String, "Wallet ID", func() {
+		Example("0f31adad-bfb6-41d1-aeff-c110ca13cbfa")
+	})
+	Attribute("transactionIds", ArrayOf(String), "Internal IDs of the cancelled transactions")
+	Attribute("compensationId", String, "Internal ID of the compensating transaction, absent if the cancelled amounts sum up to zero", func() {
+		Format(FormatUUID)
+	})
+	Attribute("reason", String, "Reason of the correction", func() {
+		Example("duplicated payout")
+	})
+	Attribute("requestedBy", String, "Support agent who requested the correction", func() {
+		Example("jane.doe")
+	})
+	Attribute("createdAt", String, "Creation time", func() {
+		Format(FormatDateTime)
+	})
+	Required("id", "walletId", "transactionIds", "reason", "requestedBy", "createdAt")
+})
+
+var CorrectionRun = Type("CorrectionRun", func() {
+	Description("Single execution of a correction")
+
+	Attribute("id", String, "ID of the run", func() {
+		Format(FormatUUID)
+	})
+	Attribute("kind", String, "Kind of the run", func() {
+		Enum("automatic", "manual")
+		Example("automatic")
+	})
+	Attribute("walletId", String, "Wallet ID", func() {
+		Example("0f31adad-bfb6-41d1-aeff-c110ca13cbfa")
+	})
+	Attribute("transactionIds", ArrayOf(String), "Internal IDs of the cancelled transactions")
+	Attribute("compensationId", String, "Internal ID of the compensating transaction, absent if the cancelled amounts sum up to zero", func() {
+		Format(FormatUUID)
+	})
+	Attribute("delta", String, "Amount of the compensating transaction", func() {
+		Example("-10.15")
+	})
+	Attribute("currency", String, "ISO 4217 currency code of the delta", func() {
+		Example("EUR")
+	})
+	Attribute("startedAt", String, "Start time of the run", func() {
+		Format(FormatDateTime)
+	})
+	Attribute("finishedAt", String, "Finish time of the run", func() {
+		Format(FormatDateTime)
+	})
+	Required("id", "kind", "walletId", "transactionIds", "delta", "currency", "startedAt")
+})
+
+var CorrectionRunList = Type("CorrectionRunList", func() {
+	Description("Page of correction runs")
+
+	Attribute("runs", ArrayOf(CorrectionRun), "Correction runs ordered from the newest to the oldest")
+	Attribute("nextCursor", String, "Cursor of the next page, absent on the last page")
+	Required("runs")
+})
+
+var _ = Service("correction", func() {
+	Description("The manual correction service")
+
+	Error("unavailable", ErrorResult, "Storage is temporarily unavailable", func() {
+		Temporary()
+	})
+	Error("invalid_input", ErrorResult, "Identifier or date is malformed")
+
+	HTTP(func() {
+		Path("/corrections")
+		Response("unavailable", StatusServiceUnavailable, func() {
+			Description("Storage is temporarily unavailable")
+		})
+		Response("invalid_input", StatusBadRequest, func() {
+			Description("Identifier or date is malformed")
+		})
+	})
+
+	// Manual correction method
+	Method("create", func() {
+		Description("Cancel done transactions of a wallet and post a single compensating internal transaction")
+
+		Payload(func() {
+			Attribute("transactionIds", ArrayOf(String, func() {
+				Format(FormatUUID)
+			}), "Internal IDs of the transactions to cancel", func() {
+				MinLength(1)
+				MaxLength(1000)
+			})
+			Attribute("reason", String, "Reason of the correction", func() {
+				MinLength(1)
+				Example("duplicated payout")
+			})
+			Attribute("requestedBy", String, "Support agent who requested the correction", func() {
+				MinLength(1)
+				MaxLength(128)
+				Example("jane.doe")
+			})
+			Required("transactionIds", "reason", "requestedBy")
+		})
+
+		Result(ManualCorrection)
+
+		Error("not_found", ErrorResult, "Some of the transactions do not exist")
+		Error("wallet_mismatch", ErrorResult, "Transactions belong to different wallets")
+		Error("mixed_currencies", ErrorResult, "Transactions are in different currencies")
+		Error("not_done", ErrorResult, "Some of the transactions are not in done status")
+
+		HTTP(func() {
+			POST("/")
+			Response(StatusCreated, func() {
+				Description("Correction created")
+				ContentType("application/json")
+			})
+			Response("not_found", StatusNotFound, func() {
+				Description("Some of the transactions do not exist")
+			})
+			Response("wallet_mismatch", StatusBadRequest, func() {
+				Description("Transactions belong to different wallets")
+			})
+			Response("mixed_currencies", StatusBadRequest, func() {
+				Description("Transactions are in different currencies")
+			})
+			Response("not_done", StatusConflict, func() {
+				Description("Some of the transactions are not in done status")
+			})
+			Response(StatusBadRequest, func() {
+				Description("Invalid input")
+			})
+			Response(StatusInternalServerError, func() {
+				Description("Internal server error")
+			})
+		})
+	})
+
+	// Correction history method
+	Method("list", func() {
+		Description("List correction runs page by page, from the newest to the oldest")
+
+		Payload(func() {
+			Attribute("walletId", String, "Wallet ID", func() {
+				Format(FormatUUID)
+				Example("0f31adad-bfb6-41d1-aeff-c110ca13cbfa")
+			})
+			Attribute("cursor", String, "Cursor returned with the previous page")
+			Attribute("limit", Int, "Maximum number of runs in the page", func() {
+				Minimum(1)
+				Maximum(500)
+				Default(50)
+			})
+		})
+
+		Result(CorrectionRunList)
+
+		Error("invalid_cursor", ErrorResult, "Cursor cannot be decoded")
+
+		HTTP(func() {
+			GET("/")
+			Param("walletId")
+			Param("cursor")
+			Param("limit")
+			Response(StatusOK, func() {
+				Description("Page of correction runs")
+				ContentType("application/json")
+			})
+			Response("invalid_cursor", StatusBadRequest, func() {
+				Description("Cursor cannot be decoded")
+			})
+			Response(StatusInternalServerError, func() {
+				Description("Internal server error")
+			})
+		})
+	})
 })
@@ -0,0 +1,61 @@
+// Code generated by goa v3.17.2, DO NOT EDIT.
+//
+// correction client
+//
+// Command:
+// $ goa gen wallet/design
+
+package correction
+
+import (
+	"context"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+// Client is the "correction" service client.
+type Client struct {
+	CreateEndpoint goa.Endpoint
+	ListEndpoint   goa.Endpoint
+}
+
+// NewClient initializes a "correction" service client given the endpoints.
+func NewClient(create, list goa.Endpoint) *Client {
+	return &Client{
+		CreateEndpoint: create,
+		ListEndpoint:   list,
+	}
+}
+
+// Create calls the "create" endpoint of the "correction" service.
+// Create may return the following errors:
+//   - "not_found" (type *goa.ServiceError): Some of the transactions do not exist
+//   - "wallet_mismatch" (type *goa.ServiceError): Transactions belong to different wallets
+//   - "mixed_currencies" (type *goa.ServiceError): Transactions are in different currencies
+//   - "not_done" (type *goa.ServiceError): Some of the transactions are not in done status
+//   - "unavailable" (type *goa.ServiceError): Storage is temporarily unavailable
+//   - "invalid_input" (type *goa.ServiceError): Identifier or date is malformed
+//   - error: internal error
+func (c *Client) Create(ctx context.Context, p *CreatePayload) (res *ManualCorrection, err error) {
+	var ires any
+	ires, err = c.CreateEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*ManualCorrection), nil
+}
+
+// List calls the "list" endpoint of the "correction" service.
+// List may return the following errors:
+//   - "invalid_cursor" (type *goa.ServiceError): Cursor cannot be decoded
+//   - "unavailable" (type *goa.ServiceError): Storage is temporarily unavailable
+//   - "invalid_input" (type *goa.ServiceError): Identifier or date is malformed
+//   - error: internal error
+func (c *Client) List(ctx context.Context, p *ListPayload) (res *CorrectionRunList, err error) {
+	var ires any
+	ires, err = c.ListEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*CorrectionRunList), nil
+}
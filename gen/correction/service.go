@@ -0,0 +1,144 @@
+// Code generated by goa v3.17.2, DO NOT EDIT.
+//
+// correction service
+//
+// Command:
+// $ goa gen wallet/design
+
+package correction
+
+import (
+	"context"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+// The manual correction service
+type Service interface {
+	// Cancel done transactions of a wallet and post a single compensating internal
+	// transaction
+	Create(context.Context, *CreatePayload) (res *ManualCorrection, err error)
+	// List correction runs page by page, from the newest to the oldest
+	List(context.Context, *ListPayload) (res *CorrectionRunList, err error)
+}
+
+// APIName is the name of the API as defined in the design.
+const APIName = "Wallet"
+
+// APIVersion is the version of the API as defined in the design.
+const APIVersion = "0.0.1"
+
+// ServiceName is the name of the service as defined in the design. This is the
+// same value that is set in the endpoint request contexts under the ServiceKey
+// key.
+const ServiceName = "correction"
+
+// MethodNames lists the service method names as defined in the design. These
+// are the same values that are set in the endpoint request contexts under the
+// MethodKey key.
+var MethodNames = [2]string{"create", "list"}
+
+// Single execution of a correction
+type CorrectionRun struct {
+	// ID of the run
+	ID string
+	// Kind of the run
+	Kind string
+	// Wallet ID
+	WalletID string
+	// Internal IDs of the cancelled transactions
+	TransactionIds []string
+	// Internal ID of the compensating transaction, absent if the cancelled amounts
+	// sum up to zero
+	CompensationID *string
+	// Amount of the compensating transaction
+	Delta string
+	// ISO 4217 currency code of the delta
+	Currency string
+	// Start time of the run
+	StartedAt string
+	// Finish time of the run
+	FinishedAt *string
+}
+
+// CorrectionRunList is the result type of the correction service list method.
+type CorrectionRunList struct {
+	// Correction runs ordered from the newest to the oldest
+	Runs []*CorrectionRun
+	// Cursor of the next page, absent on the last page
+	NextCursor *string
+}
+
+// CreatePayload is the payload type of the correction service create method.
+type CreatePayload struct {
+	// Internal IDs of the transactions to cancel
+	TransactionIds []string
+	// Reason of the correction
+	Reason string
+	// Support agent who requested the correction
+	RequestedBy string
+}
+
+// ListPayload is the payload type of the correction service list method.
+type ListPayload struct {
+	// Wallet ID
+	WalletID *string
+	// Cursor returned with the previous page
+	Cursor *string
+	// Maximum number of runs in the page
+	Limit int
+}
+
+// ManualCorrection is the result type of the correction service create method.
+type ManualCorrection struct {
+	// ID of the correction
+	ID string
+	// Wallet ID
+	WalletID string
+	// Internal IDs of the cancelled transactions
+	TransactionIds []string
+	// Internal ID of the compensating transaction, absent if the cancelled amounts
+	// sum up to zero
+	CompensationID *string
+	// Reason of the correction
+	Reason string
+	// Support agent who requested the correction
+	RequestedBy string
+	// Creation time
+	CreatedAt string
+}
+
+// MakeUnavailable builds a goa.ServiceError from an error.
+func MakeUnavailable(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "unavailable", false, true, false)
+}
+
+// MakeInvalidInput builds a goa.ServiceError from an error.
+func MakeInvalidInput(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "invalid_input", false, false, false)
+}
+
+// MakeNotFound builds a goa.ServiceError from an error.
+func MakeNotFound(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "not_found", false, false, false)
+}
+
+// MakeWalletMismatch builds a goa.ServiceError from an error.
+func MakeWalletMismatch(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "wallet_mismatch", false, false, false)
+}
+
+// MakeMixedCurrencies builds a goa.ServiceError from an error.
+func MakeMixedCurrencies(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "mixed_currencies", false, false, false)
+}
+
+// MakeNotDone builds a goa.ServiceError from an error.
+func MakeNotDone(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "not_done", false, false, false)
+}
+
+// MakeInvalidCursor builds a goa.ServiceError from an error.
+func MakeInvalidCursor(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "invalid_cursor", false, false, false)
+}
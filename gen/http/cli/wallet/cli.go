@@ -12,6 +12,7 @@ import (
 	"fmt"
 	"net/http"
 	"os"
+	correctionc "wallet/gen/http/correction/client"
 	transactionc "wallet/gen/http/transaction/client"
 
 	goahttp "goa.design/goa/v3/http"
@@ -22,13 +23,21 @@ import (
 //
 //	command (subcommand1|subcommand2|...)
 func UsageCommands() string {
-	return `transaction (healthcheck|create)
+	return `transaction (healthcheck|create|balance|show|list)
+correction (create|list)
 `
 }
 
 // UsageExamples produces an example of a valid invocation of the CLI tool.
 func UsageExamples() string {
 	return os.Args[0] + ` transaction healthcheck` + "\n" +
+		os.Args[0] + ` correction create --body '{
+      "reason": "duplicated payout",
+      "requestedBy": "jane.doe",
+      "transactionIds": [
+         "4d13609f-0dd9-4192-b32e-35d61fb8b1bb"
+      ]
+   }'` + "\n" +
 		""
 }
 
@@ -49,10 +58,46 @@ func ParseEndpoint(
 		transactionCreateFlags          = flag.NewFlagSet("create", flag.ExitOnError)
 		transactionCreateBodyFlag       = transactionCreateFlags.String("body", "REQUIRED", "")
 		transactionCreateSourceTypeFlag = transactionCreateFlags.String("source-type", "REQUIRED", "")
+		transactionCreateWaitFlag       = transactionCreateFlags.String("wait", "", "")
+
+		transactionBalanceFlags        = flag.NewFlagSet("balance", flag.ExitOnError)
+		transactionBalanceWalletIDFlag = transactionBalanceFlags.String("wallet-id", "REQUIRED", "Wallet ID")
+		transactionBalanceAsOfFlag     = transactionBalanceFlags.String("as-of", "", "")
+
+		transactionShowFlags             = flag.NewFlagSet("show", flag.ExitOnError)
+		transactionShowTransactionIDFlag = transactionShowFlags.String("transaction-id", "REQUIRED", "Transaction ID given by the source")
+		transactionShowSourceTypeFlag    = transactionShowFlags.String("source-type", "REQUIRED", "")
+
+		transactionListFlags          = flag.NewFlagSet("list", flag.ExitOnError)
+		transactionListWalletIDFlag   = transactionListFlags.String("wallet-id", "", "")
+		transactionListStatusFlag     = transactionListFlags.String("status", "", "")
+		transactionListActionFlag     = transactionListFlags.String("action", "", "")
+		transactionListSourceTypeFlag = transactionListFlags.String("source-type", "", "")
+		transactionListFromFlag       = transactionListFlags.String("from", "", "")
+		transactionListToFlag         = transactionListFlags.String("to", "", "")
+		transactionListCursorFlag     = transactionListFlags.String("cursor", "", "")
+		transactionListLimitFlag      = transactionListFlags.String("limit", "50", "")
+
+		correctionFlags = flag.NewFlagSet("correction", flag.ContinueOnError)
+
+		correctionCreateFlags    = flag.NewFlagSet("create", flag.ExitOnError)
+		correctionCreateBodyFlag = correctionCreateFlags.String("body", "REQUIRED", "")
+
+		correctionListFlags        = flag.NewFlagSet("list", flag.ExitOnError)
+		correctionListWalletIDFlag = correctionListFlags.String("wallet-id", "", "")
+		correctionListCursorFlag   = correctionListFlags.String("cursor", "", "")
+		correctionListLimitFlag    = correctionListFlags.String("limit", "50", "")
 	)
 	transactionFlags.Usage = transactionUsage
 	transactionHealthcheckFlags.Usage = transactionHealthcheckUsage
 	transactionCreateFlags.Usage = transactionCreateUsage
+	transactionBalanceFlags.Usage = transactionBalanceUsage
+	transactionShowFlags.Usage = transactionShowUsage
+	transactionListFlags.Usage = transactionListUsage
+
+	correctionFlags.Usage = correctionUsage
+	correctionCreateFlags.Usage = correctionCreateUsage
+	correctionListFlags.Usage = correctionListUsage
 
 	if err := flag.CommandLine.Parse(os.Args[1:]); err != nil {
 		return nil, nil, err
@@ -71,6 +116,8 @@ func ParseEndpoint(
 		switch svcn {
 		case "transaction":
 			svcf = transactionFlags
+		case "correction":
+			svcf = correctionFlags
 		default:
 			return nil, nil, fmt.Errorf("unknown service %q", svcn)
 		}
@@ -94,6 +141,25 @@ func ParseEndpoint(
 			case "create":
 				epf = transactionCreateFlags
 
+			case "balance":
+				epf = transactionBalanceFlags
+
+			case "show":
+				epf = transactionShowFlags
+
+			case "list":
+				epf = transactionListFlags
+
+			}
+
+		case "correction":
+			switch epn {
+			case "create":
+				epf = correctionCreateFlags
+
+			case "list":
+				epf = correctionListFlags
+
 			}
 
 		}
@@ -123,7 +189,26 @@ func ParseEndpoint(
 				endpoint = c.Healthcheck()
 			case "create":
 				endpoint = c.Create()
-				data, err = transactionc.BuildCreatePayload(*transactionCreateBodyFlag, *transactionCreateSourceTypeFlag)
+				data, err = transactionc.BuildCreatePayload(*transactionCreateBodyFlag, *transactionCreateSourceTypeFlag, *transactionCreateWaitFlag)
+			case "balance":
+				endpoint = c.Balance()
+				data, err = transactionc.BuildBalancePayload(*transactionBalanceWalletIDFlag, *transactionBalanceAsOfFlag)
+			case "show":
+				endpoint = c.Show()
+				data, err = transactionc.BuildShowPayload(*transactionShowTransactionIDFlag, *transactionShowSourceTypeFlag)
+			case "list":
+				endpoint = c.List()
+				data, err = transactionc.BuildListPayload(*transactionListWalletIDFlag, *transactionListStatusFlag, *transactionListActionFlag, *transactionListSourceTypeFlag, *transactionListFromFlag, *transactionListToFlag, *transactionListCursorFlag, *transactionListLimitFlag)
+			}
+		case "correction":
+			c := correctionc.NewClient(scheme, host, doer, enc, dec, restore)
+			switch epn {
+			case "create":
+				endpoint = c.Create()
+				data, err = correctionc.BuildCreatePayload(*correctionCreateBodyFlag)
+			case "list":
+				endpoint = c.List()
+				data, err = correctionc.BuildListPayload(*correctionListWalletIDFlag, *correctionListCursorFlag, *correctionListLimitFlag)
 			}
 		}
 	}
@@ -144,6 +229,9 @@ Usage:
 COMMAND:
     healthcheck: Check if the service is running
     create: Create a new transaction
+    balance: Retrieve the current balance of a wallet or its balance at a point in time
+    show: Retrieve the transaction and its processing status
+    list: List transactions page by page, from the newest to the oldest
 
 Additional help:
     %[1]s transaction COMMAND --help
@@ -160,17 +248,107 @@ Example:
 }
 
 func transactionCreateUsage() {
-	fmt.Fprintf(os.Stderr, `%[1]s [flags] transaction create -body JSON -source-type STRING
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] transaction create -body JSON -source-type STRING -wait BOOL
 
 Create a new transaction
     -body JSON: 
     -source-type STRING: 
+    -wait BOOL: 
 
 Example:
     %[1]s transaction create --body '{
       "amount": "10.15",
+      "currency": "EUR",
       "state": "win",
-      "transactionId": "some generated identificator"
-   }' --source-type "game"
+      "transactionId": "some generated identificator",
+      "walletId": "0f31adad-bfb6-41d1-aeff-c110ca13cbfa"
+   }' --source-type "game" --wait true
+`, os.Args[0])
+}
+
+func transactionBalanceUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] transaction balance -wallet-id STRING -as-of STRING
+
+Retrieve the current balance of a wallet or its balance at a point in time
+    -wallet-id STRING: Wallet ID
+    -as-of STRING: 
+
+Example:
+    %[1]s transaction balance --wallet-id "0f31adad-bfb6-41d1-aeff-c110ca13cbfa" --as-of "2024-07-20T18:00:00Z"
+`, os.Args[0])
+}
+
+func transactionShowUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] transaction show -transaction-id STRING -source-type STRING
+
+Retrieve the transaction and its processing status
+    -transaction-id STRING: Transaction ID given by the source
+    -source-type STRING: 
+
+Example:
+    %[1]s transaction show --transaction-id "some generated identificator" --source-type "game"
+`, os.Args[0])
+}
+
+func transactionListUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] transaction list -wallet-id STRING -status STRING -action STRING -source-type STRING -from STRING -to STRING -cursor STRING -limit INT
+
+List transactions page by page, from the newest to the oldest
+    -wallet-id STRING: 
+    -status STRING: 
+    -action STRING: 
+    -source-type STRING: 
+    -from STRING: 
+    -to STRING: 
+    -cursor STRING: 
+    -limit INT: 
+
+Example:
+    %[1]s transaction list --wallet-id "0f31adad-bfb6-41d1-aeff-c110ca13cbfa" --status "locked" --action "win" --source-type "payment" --from "2014-08-19T15:06:33Z" --to "2001-05-03T13:12:08Z" --cursor "Omnis ea." --limit 280
+`, os.Args[0])
+}
+
+// correctionUsage displays the usage of the correction command and its
+// subcommands.
+func correctionUsage() {
+	fmt.Fprintf(os.Stderr, `The manual correction service
+Usage:
+    %[1]s [globalflags] correction COMMAND [flags]
+
+COMMAND:
+    create: Cancel done transactions of a wallet and post a single compensating internal transaction
+    list: List correction runs page by page, from the newest to the oldest
+
+Additional help:
+    %[1]s correction COMMAND --help
+`, os.Args[0])
+}
+func correctionCreateUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] correction create -body JSON
+
+Cancel done transactions of a wallet and post a single compensating internal transaction
+    -body JSON: 
+
+Example:
+    %[1]s correction create --body '{
+      "reason": "duplicated payout",
+      "requestedBy": "jane.doe",
+      "transactionIds": [
+         "4d13609f-0dd9-4192-b32e-35d61fb8b1bb"
+      ]
+   }'
+`, os.Args[0])
+}
+
+func correctionListUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] correction list -wallet-id STRING -cursor STRING -limit INT
+
+List correction runs page by page, from the newest to the oldest
+    -wallet-id STRING: 
+    -cursor STRING: 
+    -limit INT: 
+
+Example:
+    %[1]s correction list --wallet-id "0f31adad-bfb6-41d1-aeff-c110ca13cbfa" --cursor "Culpa voluptas sequi nobis neque." --limit 259
 `, os.Args[0])
 }
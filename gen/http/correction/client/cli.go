@@ -0,0 +1,117 @@
+// Code generated by goa v3.17.2, DO NOT EDIT.
+//
+// correction HTTP client CLI support package
+//
+// Command:
+// $ goa gen wallet/design
+
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"unicode/utf8"
+	correction "wallet/gen/correction"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+// BuildCreatePayload builds the payload for the correction create endpoint
+// from CLI flags.
+func BuildCreatePayload(correctionCreateBody string) (*correction.CreatePayload, error) {
+	var err error
+	var body CreateRequestBody
+	{
+		err = json.Unmarshal([]byte(correctionCreateBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"reason\": \"duplicated payout\",\n      \"requestedBy\": \"jane.doe\",\n      \"transactionIds\": [\n         \"4d13609f-0dd9-4192-b32e-35d61fb8b1bb\"\n      ]\n   }'")
+		}
+		if body.TransactionIds == nil {
+			err = goa.MergeErrors(err, goa.MissingFieldError("transactionIds", "body"))
+		}
+		if len(body.TransactionIds) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.transactionIds", body.TransactionIds, len(body.TransactionIds), 1, true))
+		}
+		if len(body.TransactionIds) > 1000 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.transactionIds", body.TransactionIds, len(body.TransactionIds), 1000, false))
+		}
+		for _, e := range body.TransactionIds {
+			err = goa.MergeErrors(err, goa.ValidateFormat("body.transactionIds[*]", e, goa.FormatUUID))
+		}
+		if utf8.RuneCountInString(body.Reason) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.reason", body.Reason, utf8.RuneCountInString(body.Reason), 1, true))
+		}
+		if utf8.RuneCountInString(body.RequestedBy) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.requestedBy", body.RequestedBy, utf8.RuneCountInString(body.RequestedBy), 1, true))
+		}
+		if utf8.RuneCountInString(body.RequestedBy) > 128 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.requestedBy", body.RequestedBy, utf8.RuneCountInString(body.RequestedBy), 128, false))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	v := &correction.CreatePayload{
+		Reason:      body.Reason,
+		RequestedBy: body.RequestedBy,
+	}
+	if body.TransactionIds != nil {
+		v.TransactionIds = make([]string, len(body.TransactionIds))
+		for i, val := range body.TransactionIds {
+			v.TransactionIds[i] = val
+		}
+	} else {
+		v.TransactionIds = []string{}
+	}
+
+	return v, nil
+}
+
+// BuildListPayload builds the payload for the correction list endpoint from
+// CLI flags.
+func BuildListPayload(correctionListWalletID string, correctionListCursor string, correctionListLimit string) (*correction.ListPayload, error) {
+	var err error
+	var walletID *string
+	{
+		if correctionListWalletID != "" {
+			walletID = &correctionListWalletID
+			err = goa.MergeErrors(err, goa.ValidateFormat("walletId", *walletID, goa.FormatUUID))
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var cursor *string
+	{
+		if correctionListCursor != "" {
+			cursor = &correctionListCursor
+		}
+	}
+	var limit int
+	{
+		if correctionListLimit != "" {
+			var v int64
+			v, err = strconv.ParseInt(correctionListLimit, 10, strconv.IntSize)
+			limit = int(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for limit, must be INT")
+			}
+			if limit < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 1, true))
+			}
+			if limit > 500 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 500, false))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	v := &correction.ListPayload{}
+	v.WalletID = walletID
+	v.Cursor = cursor
+	v.Limit = limit
+
+	return v, nil
+}
@@ -0,0 +1,342 @@
+// Code generated by goa v3.17.2, DO NOT EDIT.
+//
+// correction HTTP client encoders and decoders
+//
+// Command:
+// $ goa gen wallet/design
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	correction "wallet/gen/correction"
+
+	goahttp "goa.design/goa/v3/http"
+)
+
+// BuildCreateRequest instantiates a HTTP request object with method and path
+// set to call the "correction" service "create" endpoint
+func (c *Client) BuildCreateRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: CreateCorrectionPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("correction", "create", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeCreateRequest returns an encoder for requests sent to the correction
+// create server.
+func EncodeCreateRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*correction.CreatePayload)
+		if !ok {
+			return goahttp.ErrInvalidType("correction", "create", "*correction.CreatePayload", v)
+		}
+		body := NewCreateRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("correction", "create", err)
+		}
+		return nil
+	}
+}
+
+// DecodeCreateResponse returns a decoder for responses returned by the
+// correction create endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeCreateResponse may return the following errors:
+//   - "not_found" (type *goa.ServiceError): http.StatusNotFound
+//   - "wallet_mismatch" (type *goa.ServiceError): http.StatusBadRequest
+//   - "mixed_currencies" (type *goa.ServiceError): http.StatusBadRequest
+//   - "invalid_input" (type *goa.ServiceError): http.StatusBadRequest
+//   - "not_done" (type *goa.ServiceError): http.StatusConflict
+//   - "unavailable" (type *goa.ServiceError): http.StatusServiceUnavailable
+//   - error: internal error
+func DecodeCreateResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusCreated:
+			var (
+				body CreateCreatedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("correction", "create", err)
+			}
+			err = ValidateCreateCreatedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("correction", "create", err)
+			}
+			res := NewCreateManualCorrectionCreated(&body)
+			return res, nil
+		case http.StatusNotFound:
+			var (
+				body CreateNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("correction", "create", err)
+			}
+			err = ValidateCreateNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("correction", "create", err)
+			}
+			return nil, NewCreateNotFound(&body)
+		case http.StatusBadRequest:
+			en := resp.Header.Get("goa-error")
+			switch en {
+			case "wallet_mismatch":
+				var (
+					body CreateWalletMismatchResponseBody
+					err  error
+				)
+				err = decoder(resp).Decode(&body)
+				if err != nil {
+					return nil, goahttp.ErrDecodingError("correction", "create", err)
+				}
+				err = ValidateCreateWalletMismatchResponseBody(&body)
+				if err != nil {
+					return nil, goahttp.ErrValidationError("correction", "create", err)
+				}
+				return nil, NewCreateWalletMismatch(&body)
+			case "mixed_currencies":
+				var (
+					body CreateMixedCurrenciesResponseBody
+					err  error
+				)
+				err = decoder(resp).Decode(&body)
+				if err != nil {
+					return nil, goahttp.ErrDecodingError("correction", "create", err)
+				}
+				err = ValidateCreateMixedCurrenciesResponseBody(&body)
+				if err != nil {
+					return nil, goahttp.ErrValidationError("correction", "create", err)
+				}
+				return nil, NewCreateMixedCurrencies(&body)
+			case "invalid_input":
+				var (
+					body CreateInvalidInputResponseBody
+					err  error
+				)
+				err = decoder(resp).Decode(&body)
+				if err != nil {
+					return nil, goahttp.ErrDecodingError("correction", "create", err)
+				}
+				err = ValidateCreateInvalidInputResponseBody(&body)
+				if err != nil {
+					return nil, goahttp.ErrValidationError("correction", "create", err)
+				}
+				return nil, NewCreateInvalidInput(&body)
+			default:
+				body, _ := io.ReadAll(resp.Body)
+				return nil, goahttp.ErrInvalidResponse("correction", "create", resp.StatusCode, string(body))
+			}
+		case http.StatusConflict:
+			var (
+				body CreateNotDoneResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("correction", "create", err)
+			}
+			err = ValidateCreateNotDoneResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("correction", "create", err)
+			}
+			return nil, NewCreateNotDone(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body CreateUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("correction", "create", err)
+			}
+			err = ValidateCreateUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("correction", "create", err)
+			}
+			return nil, NewCreateUnavailable(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("correction", "create", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildListRequest instantiates a HTTP request object with method and path set
+// to call the "correction" service "list" endpoint
+func (c *Client) BuildListRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListCorrectionPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("correction", "list", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeListRequest returns an encoder for requests sent to the correction
+// list server.
+func EncodeListRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*correction.ListPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("correction", "list", "*correction.ListPayload", v)
+		}
+		values := req.URL.Query()
+		if p.WalletID != nil {
+			values.Add("walletId", *p.WalletID)
+		}
+		if p.Cursor != nil {
+			values.Add("cursor", *p.Cursor)
+		}
+		values.Add("limit", fmt.Sprintf("%v", p.Limit))
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeListResponse returns a decoder for responses returned by the
+// correction list endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeListResponse may return the following errors:
+//   - "invalid_cursor" (type *goa.ServiceError): http.StatusBadRequest
+//   - "invalid_input" (type *goa.ServiceError): http.StatusBadRequest
+//   - "unavailable" (type *goa.ServiceError): http.StatusServiceUnavailable
+//   - error: internal error
+func DecodeListResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ListOKResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("correction", "list", err)
+			}
+			err = ValidateListOKResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("correction", "list", err)
+			}
+			res := NewListCorrectionRunListOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			en := resp.Header.Get("goa-error")
+			switch en {
+			case "invalid_cursor":
+				var (
+					body ListInvalidCursorResponseBody
+					err  error
+				)
+				err = decoder(resp).Decode(&body)
+				if err != nil {
+					return nil, goahttp.ErrDecodingError("correction", "list", err)
+				}
+				err = ValidateListInvalidCursorResponseBody(&body)
+				if err != nil {
+					return nil, goahttp.ErrValidationError("correction", "list", err)
+				}
+				return nil, NewListInvalidCursor(&body)
+			case "invalid_input":
+				var (
+					body ListInvalidInputResponseBody
+					err  error
+				)
+				err = decoder(resp).Decode(&body)
+				if err != nil {
+					return nil, goahttp.ErrDecodingError("correction", "list", err)
+				}
+				err = ValidateListInvalidInputResponseBody(&body)
+				if err != nil {
+					return nil, goahttp.ErrValidationError("correction", "list", err)
+				}
+				return nil, NewListInvalidInput(&body)
+			default:
+				body, _ := io.ReadAll(resp.Body)
+				return nil, goahttp.ErrInvalidResponse("correction", "list", resp.StatusCode, string(body))
+			}
+		case http.StatusServiceUnavailable:
+			var (
+				body ListUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("correction", "list", err)
+			}
+			err = ValidateListUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("correction", "list", err)
+			}
+			return nil, NewListUnavailable(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("correction", "list", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// unmarshalCorrectionRunResponseBodyToCorrectionCorrectionRun builds a value
+// of type *correction.CorrectionRun from a value of type
+// *CorrectionRunResponseBody.
+func unmarshalCorrectionRunResponseBodyToCorrectionCorrectionRun(v *CorrectionRunResponseBody) *correction.CorrectionRun {
+	res := &correction.CorrectionRun{
+		ID:             *v.ID,
+		Kind:           *v.Kind,
+		WalletID:       *v.WalletID,
+		CompensationID: v.CompensationID,
+		Delta:          *v.Delta,
+		Currency:       *v.Currency,
+		StartedAt:      *v.StartedAt,
+		FinishedAt:     v.FinishedAt,
+	}
+	res.TransactionIds = make([]string, len(v.TransactionIds))
+	for i, val := range v.TransactionIds {
+		res.TransactionIds[i] = val
+	}
+
+	return res
+}
@@ -0,0 +1,18 @@
+// Code generated by goa v3.17.2, DO NOT EDIT.
+//
+// HTTP request path constructors for the correction service.
+//
+// Command:
+// $ goa gen wallet/design
+
+package client
+
+// CreateCorrectionPath returns the URL path to the correction service create HTTP endpoint.
+func CreateCorrectionPath() string {
+	return "/corrections"
+}
+
+// ListCorrectionPath returns the URL path to the correction service list HTTP endpoint.
+func ListCorrectionPath() string {
+	return "/corrections"
+}
@@ -0,0 +1,862 @@
+// Code generated by goa v3.17.2, DO NOT EDIT.
+//
+// correction HTTP client types
+//
+// Command:
+// $ goa gen wallet/design
+
+package client
+
+import (
+	correction "wallet/gen/correction"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+// CreateRequestBody is the type of the "correction" service "create" endpoint
+// HTTP request body.
+type CreateRequestBody struct {
+	// Internal IDs of the transactions to cancel
+	TransactionIds []string `form:"transactionIds" json:"transactionIds" xml:"transactionIds"`
+	// Reason of the correction
+	Reason string `form:"reason" json:"reason" xml:"reason"`
+	// Support agent who requested the correction
+	RequestedBy string `form:"requestedBy" json:"requestedBy" xml:"requestedBy"`
+}
+
+// CreateCreatedResponseBody is the type of the "correction" service "create"
+// endpoint HTTP response body.
+type CreateCreatedResponseBody struct {
+	// ID of the correction
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Wallet ID
+	WalletID *string `form:"walletId,omitempty" json:"walletId,omitempty" xml:"walletId,omitempty"`
+	// Internal IDs of the cancelled transactions
+	TransactionIds []string `form:"transactionIds,omitempty" json:"transactionIds,omitempty" xml:"transactionIds,omitempty"`
+	// Internal ID of the compensating transaction, absent if the cancelled amounts
+	// sum up to zero
+	CompensationID *string `form:"compensationId,omitempty" json:"compensationId,omitempty" xml:"compensationId,omitempty"`
+	// Reason of the correction
+	Reason *string `form:"reason,omitempty" json:"reason,omitempty" xml:"reason,omitempty"`
+	// Support agent who requested the correction
+	RequestedBy *string `form:"requestedBy,omitempty" json:"requestedBy,omitempty" xml:"requestedBy,omitempty"`
+	// Creation time
+	CreatedAt *string `form:"createdAt,omitempty" json:"createdAt,omitempty" xml:"createdAt,omitempty"`
+}
+
+// ListOKResponseBody is the type of the "correction" service "list" endpoint
+// HTTP response body.
+type ListOKResponseBody struct {
+	// Correction runs ordered from the newest to the oldest
+	Runs []*CorrectionRunResponseBody `form:"runs,omitempty" json:"runs,omitempty" xml:"runs,omitempty"`
+	// Cursor of the next page, absent on the last page
+	NextCursor *string `form:"nextCursor,omitempty" json:"nextCursor,omitempty" xml:"nextCursor,omitempty"`
+}
+
+// CreateNotFoundResponseBody is the type of the "correction" service "create"
+// endpoint HTTP response body for the "not_found" error.
+type CreateNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// CreateWalletMismatchResponseBody is the type of the "correction" service
+// "create" endpoint HTTP response body for the "wallet_mismatch" error.
+type CreateWalletMismatchResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// CreateMixedCurrenciesResponseBody is the type of the "correction" service
+// "create" endpoint HTTP response body for the "mixed_currencies" error.
+type CreateMixedCurrenciesResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// CreateInvalidInputResponseBody is the type of the "correction" service
+// "create" endpoint HTTP response body for the "invalid_input" error.
+type CreateInvalidInputResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// CreateNotDoneResponseBody is the type of the "correction" service "create"
+// endpoint HTTP response body for the "not_done" error.
+type CreateNotDoneResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// CreateUnavailableResponseBody is the type of the "correction" service
+// "create" endpoint HTTP response body for the "unavailable" error.
+type CreateUnavailableResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ListInvalidCursorResponseBody is the type of the "correction" service "list"
+// endpoint HTTP response body for the "invalid_cursor" error.
+type ListInvalidCursorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ListInvalidInputResponseBody is the type of the "correction" service "list"
+// endpoint HTTP response body for the "invalid_input" error.
+type ListInvalidInputResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ListUnavailableResponseBody is the type of the "correction" service "list"
+// endpoint HTTP response body for the "unavailable" error.
+type ListUnavailableResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// CreateBadRequestResponseBody is used to define fields on response body types.
+type CreateBadRequestResponseBody struct {
+	// ID of the correction
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Wallet ID
+	WalletID *string `form:"walletId,omitempty" json:"walletId,omitempty" xml:"walletId,omitempty"`
+	// Internal IDs of the cancelled transactions
+	TransactionIds []string `form:"transactionIds,omitempty" json:"transactionIds,omitempty" xml:"transactionIds,omitempty"`
+	// Internal ID of the compensating transaction, absent if the cancelled amounts
+	// sum up to zero
+	CompensationID *string `form:"compensationId,omitempty" json:"compensationId,omitempty" xml:"compensationId,omitempty"`
+	// Reason of the correction
+	Reason *string `form:"reason,omitempty" json:"reason,omitempty" xml:"reason,omitempty"`
+	// Support agent who requested the correction
+	RequestedBy *string `form:"requestedBy,omitempty" json:"requestedBy,omitempty" xml:"requestedBy,omitempty"`
+	// Creation time
+	CreatedAt *string `form:"createdAt,omitempty" json:"createdAt,omitempty" xml:"createdAt,omitempty"`
+}
+
+// CreateInternalServerErrorResponseBody is used to define fields on response
+// body types.
+type CreateInternalServerErrorResponseBody struct {
+	// ID of the correction
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Wallet ID
+	WalletID *string `form:"walletId,omitempty" json:"walletId,omitempty" xml:"walletId,omitempty"`
+	// Internal IDs of the cancelled transactions
+	TransactionIds []string `form:"transactionIds,omitempty" json:"transactionIds,omitempty" xml:"transactionIds,omitempty"`
+	// Internal ID of the compensating transaction, absent if the cancelled amounts
+	// sum up to zero
+	CompensationID *string `form:"compensationId,omitempty" json:"compensationId,omitempty" xml:"compensationId,omitempty"`
+	// Reason of the correction
+	Reason *string `form:"reason,omitempty" json:"reason,omitempty" xml:"reason,omitempty"`
+	// Support agent who requested the correction
+	RequestedBy *string `form:"requestedBy,omitempty" json:"requestedBy,omitempty" xml:"requestedBy,omitempty"`
+	// Creation time
+	CreatedAt *string `form:"createdAt,omitempty" json:"createdAt,omitempty" xml:"createdAt,omitempty"`
+}
+
+// CorrectionRunResponseBody is used to define fields on response body types.
+type CorrectionRunResponseBody struct {
+	// ID of the run
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Kind of the run
+	Kind *string `form:"kind,omitempty" json:"kind,omitempty" xml:"kind,omitempty"`
+	// Wallet ID
+	WalletID *string `form:"walletId,omitempty" json:"walletId,omitempty" xml:"walletId,omitempty"`
+	// Internal IDs of the cancelled transactions
+	TransactionIds []string `form:"transactionIds,omitempty" json:"transactionIds,omitempty" xml:"transactionIds,omitempty"`
+	// Internal ID of the compensating transaction, absent if the cancelled amounts
+	// sum up to zero
+	CompensationID *string `form:"compensationId,omitempty" json:"compensationId,omitempty" xml:"compensationId,omitempty"`
+	// Amount of the compensating transaction
+	Delta *string `form:"delta,omitempty" json:"delta,omitempty" xml:"delta,omitempty"`
+	// ISO 4217 currency code of the delta
+	Currency *string `form:"currency,omitempty" json:"currency,omitempty" xml:"currency,omitempty"`
+	// Start time of the run
+	StartedAt *string `form:"startedAt,omitempty" json:"startedAt,omitempty" xml:"startedAt,omitempty"`
+	// Finish time of the run
+	FinishedAt *string `form:"finishedAt,omitempty" json:"finishedAt,omitempty" xml:"finishedAt,omitempty"`
+}
+
+// ListInternalServerErrorResponseBody is used to define fields on response
+// body types.
+type ListInternalServerErrorResponseBody struct {
+	// Correction runs ordered from the newest to the oldest
+	Runs []*CorrectionRunResponseBody `form:"runs,omitempty" json:"runs,omitempty" xml:"runs,omitempty"`
+	// Cursor of the next page, absent on the last page
+	NextCursor *string `form:"nextCursor,omitempty" json:"nextCursor,omitempty" xml:"nextCursor,omitempty"`
+}
+
+// NewCreateRequestBody builds the HTTP request body from the payload of the
+// "create" endpoint of the "correction" service.
+func NewCreateRequestBody(p *correction.CreatePayload) *CreateRequestBody {
+	body := &CreateRequestBody{
+		Reason:      p.Reason,
+		RequestedBy: p.RequestedBy,
+	}
+	if p.TransactionIds != nil {
+		body.TransactionIds = make([]string, len(p.TransactionIds))
+		for i, val := range p.TransactionIds {
+			body.TransactionIds[i] = val
+		}
+	} else {
+		body.TransactionIds = []string{}
+	}
+	return body
+}
+
+// NewCreateManualCorrectionCreated builds a "correction" service "create"
+// endpoint result from a HTTP "Created" response.
+func NewCreateManualCorrectionCreated(body *CreateCreatedResponseBody) *correction.ManualCorrection {
+	v := &correction.ManualCorrection{
+		ID:             *body.ID,
+		WalletID:       *body.WalletID,
+		CompensationID: body.CompensationID,
+		Reason:         *body.Reason,
+		RequestedBy:    *body.RequestedBy,
+		CreatedAt:      *body.CreatedAt,
+	}
+	v.TransactionIds = make([]string, len(body.TransactionIds))
+	for i, val := range body.TransactionIds {
+		v.TransactionIds[i] = val
+	}
+
+	return v
+}
+
+// NewCreateNotFound builds a correction service create endpoint not_found
+// error.
+func NewCreateNotFound(body *CreateNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCreateWalletMismatch builds a correction service create endpoint
+// wallet_mismatch error.
+func NewCreateWalletMismatch(body *CreateWalletMismatchResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCreateMixedCurrencies builds a correction service create endpoint
+// mixed_currencies error.
+func NewCreateMixedCurrencies(body *CreateMixedCurrenciesResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCreateInvalidInput builds a correction service create endpoint
+// invalid_input error.
+func NewCreateInvalidInput(body *CreateInvalidInputResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCreateNotDone builds a correction service create endpoint not_done error.
+func NewCreateNotDone(body *CreateNotDoneResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCreateUnavailable builds a correction service create endpoint unavailable
+// error.
+func NewCreateUnavailable(body *CreateUnavailableResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewListCorrectionRunListOK builds a "correction" service "list" endpoint
+// result from a HTTP "OK" response.
+func NewListCorrectionRunListOK(body *ListOKResponseBody) *correction.CorrectionRunList {
+	v := &correction.CorrectionRunList{
+		NextCursor: body.NextCursor,
+	}
+	v.Runs = make([]*correction.CorrectionRun, len(body.Runs))
+	for i, val := range body.Runs {
+		v.Runs[i] = unmarshalCorrectionRunResponseBodyToCorrectionCorrectionRun(val)
+	}
+
+	return v
+}
+
+// NewListInvalidCursor builds a correction service list endpoint
+// invalid_cursor error.
+func NewListInvalidCursor(body *ListInvalidCursorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewListInvalidInput builds a correction service list endpoint invalid_input
+// error.
+func NewListInvalidInput(body *ListInvalidInputResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewListUnavailable builds a correction service list endpoint unavailable
+// error.
+func NewListUnavailable(body *ListUnavailableResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// ValidateCreateCreatedResponseBody runs the validations defined on
+// CreateCreatedResponseBody
+func ValidateCreateCreatedResponseBody(body *CreateCreatedResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.WalletID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("walletId", "body"))
+	}
+	if body.TransactionIds == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("transactionIds", "body"))
+	}
+	if body.Reason == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("reason", "body"))
+	}
+	if body.RequestedBy == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("requestedBy", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("createdAt", "body"))
+	}
+	if body.ID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.id", *body.ID, goa.FormatUUID))
+	}
+	if body.CompensationID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.compensationId", *body.CompensationID, goa.FormatUUID))
+	}
+	if body.CreatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.createdAt", *body.CreatedAt, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateListOKResponseBody runs the validations defined on ListOKResponseBody
+func ValidateListOKResponseBody(body *ListOKResponseBody) (err error) {
+	if body.Runs == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("runs", "body"))
+	}
+	for _, e := range body.Runs {
+		if e != nil {
+			if err2 := ValidateCorrectionRunResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateCreateNotFoundResponseBody runs the validations defined on
+// create_not_found_response_body
+func ValidateCreateNotFoundResponseBody(body *CreateNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateCreateWalletMismatchResponseBody runs the validations defined on
+// create_wallet_mismatch_response_body
+func ValidateCreateWalletMismatchResponseBody(body *CreateWalletMismatchResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateCreateMixedCurrenciesResponseBody runs the validations defined on
+// create_mixed_currencies_response_body
+func ValidateCreateMixedCurrenciesResponseBody(body *CreateMixedCurrenciesResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateCreateInvalidInputResponseBody runs the validations defined on
+// create_invalid_input_response_body
+func ValidateCreateInvalidInputResponseBody(body *CreateInvalidInputResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateCreateNotDoneResponseBody runs the validations defined on
+// create_not_done_response_body
+func ValidateCreateNotDoneResponseBody(body *CreateNotDoneResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateCreateUnavailableResponseBody runs the validations defined on
+// create_unavailable_response_body
+func ValidateCreateUnavailableResponseBody(body *CreateUnavailableResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateListInvalidCursorResponseBody runs the validations defined on
+// list_invalid_cursor_response_body
+func ValidateListInvalidCursorResponseBody(body *ListInvalidCursorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateListInvalidInputResponseBody runs the validations defined on
+// list_invalid_input_response_body
+func ValidateListInvalidInputResponseBody(body *ListInvalidInputResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateListUnavailableResponseBody runs the validations defined on
+// list_unavailable_response_body
+func ValidateListUnavailableResponseBody(body *ListUnavailableResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateCreateBadRequestResponseBody runs the validations defined on
+// CreateBad RequestResponseBody
+func ValidateCreateBadRequestResponseBody(body *CreateBadRequestResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.WalletID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("walletId", "body"))
+	}
+	if body.TransactionIds == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("transactionIds", "body"))
+	}
+	if body.Reason == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("reason", "body"))
+	}
+	if body.RequestedBy == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("requestedBy", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("createdAt", "body"))
+	}
+	if body.ID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.id", *body.ID, goa.FormatUUID))
+	}
+	if body.CompensationID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.compensationId", *body.CompensationID, goa.FormatUUID))
+	}
+	if body.CreatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.createdAt", *body.CreatedAt, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateCreateInternalServerErrorResponseBody runs the validations defined
+// on CreateInternal Server ErrorResponseBody
+func ValidateCreateInternalServerErrorResponseBody(body *CreateInternalServerErrorResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.WalletID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("walletId", "body"))
+	}
+	if body.TransactionIds == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("transactionIds", "body"))
+	}
+	if body.Reason == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("reason", "body"))
+	}
+	if body.RequestedBy == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("requestedBy", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("createdAt", "body"))
+	}
+	if body.ID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.id", *body.ID, goa.FormatUUID))
+	}
+	if body.CompensationID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.compensationId", *body.CompensationID, goa.FormatUUID))
+	}
+	if body.CreatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.createdAt", *body.CreatedAt, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateCorrectionRunResponseBody runs the validations defined on
+// CorrectionRunResponseBody
+func ValidateCorrectionRunResponseBody(body *CorrectionRunResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Kind == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("kind", "body"))
+	}
+	if body.WalletID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("walletId", "body"))
+	}
+	if body.TransactionIds == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("transactionIds", "body"))
+	}
+	if body.Delta == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("delta", "body"))
+	}
+	if body.Currency == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("currency", "body"))
+	}
+	if body.StartedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("startedAt", "body"))
+	}
+	if body.ID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.id", *body.ID, goa.FormatUUID))
+	}
+	if body.Kind != nil {
+		if !(*body.Kind == "automatic" || *body.Kind == "manual") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.kind", *body.Kind, []any{"automatic", "manual"}))
+		}
+	}
+	if body.CompensationID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.compensationId", *body.CompensationID, goa.FormatUUID))
+	}
+	if body.StartedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.startedAt", *body.StartedAt, goa.FormatDateTime))
+	}
+	if body.FinishedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.finishedAt", *body.FinishedAt, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateListInternalServerErrorResponseBody runs the validations defined on
+// ListInternal Server ErrorResponseBody
+func ValidateListInternalServerErrorResponseBody(body *ListInternalServerErrorResponseBody) (err error) {
+	if body.Runs == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("runs", "body"))
+	}
+	for _, e := range body.Runs {
+		if e != nil {
+			if err2 := ValidateCorrectionRunResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
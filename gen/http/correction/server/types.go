@@ -0,0 +1,467 @@
+// Code generated by goa v3.17.2, DO NOT EDIT.
+//
+// correction HTTP server types
+//
+// Command:
+// $ goa gen wallet/design
+
+package server
+
+import (
+	"unicode/utf8"
+	correction "wallet/gen/correction"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+// CreateRequestBody is the type of the "correction" service "create" endpoint
+// HTTP request body.
+type CreateRequestBody struct {
+	// Internal IDs of the transactions to cancel
+	TransactionIds []string `form:"transactionIds,omitempty" json:"transactionIds,omitempty" xml:"transactionIds,omitempty"`
+	// Reason of the correction
+	Reason *string `form:"reason,omitempty" json:"reason,omitempty" xml:"reason,omitempty"`
+	// Support agent who requested the correction
+	RequestedBy *string `form:"requestedBy,omitempty" json:"requestedBy,omitempty" xml:"requestedBy,omitempty"`
+}
+
+// CreateCreatedResponseBody is the type of the "correction" service "create"
+// endpoint HTTP response body.
+type CreateCreatedResponseBody struct {
+	// ID of the correction
+	ID string `form:"id" json:"id" xml:"id"`
+	// Wallet ID
+	WalletID string `form:"walletId" json:"walletId" xml:"walletId"`
+	// Internal IDs of the cancelled transactions
+	TransactionIds []string `form:"transactionIds" json:"transactionIds" xml:"transactionIds"`
+	// Internal ID of the compensating transaction, absent if the cancelled amounts
+	// sum up to zero
+	CompensationID *string `form:"compensationId,omitempty" json:"compensationId,omitempty" xml:"compensationId,omitempty"`
+	// Reason of the correction
+	Reason string `form:"reason" json:"reason" xml:"reason"`
+	// Support agent who requested the correction
+	RequestedBy string `form:"requestedBy" json:"requestedBy" xml:"requestedBy"`
+	// Creation time
+	CreatedAt string `form:"createdAt" json:"createdAt" xml:"createdAt"`
+}
+
+// ListOKResponseBody is the type of the "correction" service "list" endpoint
+// HTTP response body.
+type ListOKResponseBody struct {
+	// Correction runs ordered from the newest to the oldest
+	Runs []*CorrectionRunResponseBody `form:"runs" json:"runs" xml:"runs"`
+	// Cursor of the next page, absent on the last page
+	NextCursor *string `form:"nextCursor,omitempty" json:"nextCursor,omitempty" xml:"nextCursor,omitempty"`
+}
+
+// CreateNotFoundResponseBody is the type of the "correction" service "create"
+// endpoint HTTP response body for the "not_found" error.
+type CreateNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// CreateWalletMismatchResponseBody is the type of the "correction" service
+// "create" endpoint HTTP response body for the "wallet_mismatch" error.
+type CreateWalletMismatchResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// CreateMixedCurrenciesResponseBody is the type of the "correction" service
+// "create" endpoint HTTP response body for the "mixed_currencies" error.
+type CreateMixedCurrenciesResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// CreateInvalidInputResponseBody is the type of the "correction" service
+// "create" endpoint HTTP response body for the "invalid_input" error.
+type CreateInvalidInputResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// CreateNotDoneResponseBody is the type of the "correction" service "create"
+// endpoint HTTP response body for the "not_done" error.
+type CreateNotDoneResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// CreateUnavailableResponseBody is the type of the "correction" service
+// "create" endpoint HTTP response body for the "unavailable" error.
+type CreateUnavailableResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ListInvalidCursorResponseBody is the type of the "correction" service "list"
+// endpoint HTTP response body for the "invalid_cursor" error.
+type ListInvalidCursorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ListInvalidInputResponseBody is the type of the "correction" service "list"
+// endpoint HTTP response body for the "invalid_input" error.
+type ListInvalidInputResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ListUnavailableResponseBody is the type of the "correction" service "list"
+// endpoint HTTP response body for the "unavailable" error.
+type ListUnavailableResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// CorrectionRunResponseBody is used to define fields on response body types.
+type CorrectionRunResponseBody struct {
+	// ID of the run
+	ID string `form:"id" json:"id" xml:"id"`
+	// Kind of the run
+	Kind string `form:"kind" json:"kind" xml:"kind"`
+	// Wallet ID
+	WalletID string `form:"walletId" json:"walletId" xml:"walletId"`
+	// Internal IDs of the cancelled transactions
+	TransactionIds []string `form:"transactionIds" json:"transactionIds" xml:"transactionIds"`
+	// Internal ID of the compensating transaction, absent if the cancelled amounts
+	// sum up to zero
+	CompensationID *string `form:"compensationId,omitempty" json:"compensationId,omitempty" xml:"compensationId,omitempty"`
+	// Amount of the compensating transaction
+	Delta string `form:"delta" json:"delta" xml:"delta"`
+	// ISO 4217 currency code of the delta
+	Currency string `form:"currency" json:"currency" xml:"currency"`
+	// Start time of the run
+	StartedAt string `form:"startedAt" json:"startedAt" xml:"startedAt"`
+	// Finish time of the run
+	FinishedAt *string `form:"finishedAt,omitempty" json:"finishedAt,omitempty" xml:"finishedAt,omitempty"`
+}
+
+// NewCreateCreatedResponseBody builds the HTTP response body from the result
+// of the "create" endpoint of the "correction" service.
+func NewCreateCreatedResponseBody(res *correction.ManualCorrection) *CreateCreatedResponseBody {
+	body := &CreateCreatedResponseBody{
+		ID:             res.ID,
+		WalletID:       res.WalletID,
+		CompensationID: res.CompensationID,
+		Reason:         res.Reason,
+		RequestedBy:    res.RequestedBy,
+		CreatedAt:      res.CreatedAt,
+	}
+	if res.TransactionIds != nil {
+		body.TransactionIds = make([]string, len(res.TransactionIds))
+		for i, val := range res.TransactionIds {
+			body.TransactionIds[i] = val
+		}
+	} else {
+		body.TransactionIds = []string{}
+	}
+	return body
+}
+
+// NewListOKResponseBody builds the HTTP response body from the result of the
+// "list" endpoint of the "correction" service.
+func NewListOKResponseBody(res *correction.CorrectionRunList) *ListOKResponseBody {
+	body := &ListOKResponseBody{
+		NextCursor: res.NextCursor,
+	}
+	if res.Runs != nil {
+		body.Runs = make([]*CorrectionRunResponseBody, len(res.Runs))
+		for i, val := range res.Runs {
+			body.Runs[i] = marshalCorrectionCorrectionRunToCorrectionRunResponseBody(val)
+		}
+	} else {
+		body.Runs = []*CorrectionRunResponseBody{}
+	}
+	return body
+}
+
+// NewCreateNotFoundResponseBody builds the HTTP response body from the result
+// of the "create" endpoint of the "correction" service.
+func NewCreateNotFoundResponseBody(res *goa.ServiceError) *CreateNotFoundResponseBody {
+	body := &CreateNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCreateWalletMismatchResponseBody builds the HTTP response body from the
+// result of the "create" endpoint of the "correction" service.
+func NewCreateWalletMismatchResponseBody(res *goa.ServiceError) *CreateWalletMismatchResponseBody {
+	body := &CreateWalletMismatchResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCreateMixedCurrenciesResponseBody builds the HTTP response body from the
+// result of the "create" endpoint of the "correction" service.
+func NewCreateMixedCurrenciesResponseBody(res *goa.ServiceError) *CreateMixedCurrenciesResponseBody {
+	body := &CreateMixedCurrenciesResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCreateInvalidInputResponseBody builds the HTTP response body from the
+// result of the "create" endpoint of the "correction" service.
+func NewCreateInvalidInputResponseBody(res *goa.ServiceError) *CreateInvalidInputResponseBody {
+	body := &CreateInvalidInputResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCreateNotDoneResponseBody builds the HTTP response body from the result
+// of the "create" endpoint of the "correction" service.
+func NewCreateNotDoneResponseBody(res *goa.ServiceError) *CreateNotDoneResponseBody {
+	body := &CreateNotDoneResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCreateUnavailableResponseBody builds the HTTP response body from the
+// result of the "create" endpoint of the "correction" service.
+func NewCreateUnavailableResponseBody(res *goa.ServiceError) *CreateUnavailableResponseBody {
+	body := &CreateUnavailableResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewListInvalidCursorResponseBody builds the HTTP response body from the
+// result of the "list" endpoint of the "correction" service.
+func NewListInvalidCursorResponseBody(res *goa.ServiceError) *ListInvalidCursorResponseBody {
+	body := &ListInvalidCursorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewListInvalidInputResponseBody builds the HTTP response body from the
+// result of the "list" endpoint of the "correction" service.
+func NewListInvalidInputResponseBody(res *goa.ServiceError) *ListInvalidInputResponseBody {
+	body := &ListInvalidInputResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewListUnavailableResponseBody builds the HTTP response body from the result
+// of the "list" endpoint of the "correction" service.
+func NewListUnavailableResponseBody(res *goa.ServiceError) *ListUnavailableResponseBody {
+	body := &ListUnavailableResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCreatePayload builds a correction service create endpoint payload.
+func NewCreatePayload(body *CreateRequestBody) *correction.CreatePayload {
+	v := &correction.CreatePayload{
+		Reason:      *body.Reason,
+		RequestedBy: *body.RequestedBy,
+	}
+	v.TransactionIds = make([]string, len(body.TransactionIds))
+	for i, val := range body.TransactionIds {
+		v.TransactionIds[i] = val
+	}
+
+	return v
+}
+
+// NewListPayload builds a correction service list endpoint payload.
+func NewListPayload(walletID *string, cursor *string, limit int) *correction.ListPayload {
+	v := &correction.ListPayload{}
+	v.WalletID = walletID
+	v.Cursor = cursor
+	v.Limit = limit
+
+	return v
+}
+
+// ValidateCreateRequestBody runs the validations defined on CreateRequestBody
+func ValidateCreateRequestBody(body *CreateRequestBody) (err error) {
+	if body.TransactionIds == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("transactionIds", "body"))
+	}
+	if body.Reason == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("reason", "body"))
+	}
+	if body.RequestedBy == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("requestedBy", "body"))
+	}
+	if len(body.TransactionIds) < 1 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("body.transactionIds", body.TransactionIds, len(body.TransactionIds), 1, true))
+	}
+	if len(body.TransactionIds) > 1000 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("body.transactionIds", body.TransactionIds, len(body.TransactionIds), 1000, false))
+	}
+	for _, e := range body.TransactionIds {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.transactionIds[*]", e, goa.FormatUUID))
+	}
+	if body.Reason != nil {
+		if utf8.RuneCountInString(*body.Reason) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.reason", *body.Reason, utf8.RuneCountInString(*body.Reason), 1, true))
+		}
+	}
+	if body.RequestedBy != nil {
+		if utf8.RuneCountInString(*body.RequestedBy) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.requestedBy", *body.RequestedBy, utf8.RuneCountInString(*body.RequestedBy), 1, true))
+		}
+	}
+	if body.RequestedBy != nil {
+		if utf8.RuneCountInString(*body.RequestedBy) > 128 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.requestedBy", *body.RequestedBy, utf8.RuneCountInString(*body.RequestedBy), 128, false))
+		}
+	}
+	return
+}
@@ -10,6 +10,7 @@ package client
 import (
 	"encoding/json"
 	"fmt"
+	"strconv"
 	transaction "wallet/gen/transaction"
 
 	goa "goa.design/goa/v3/pkg"
@@ -17,17 +18,19 @@ import (
 
 // BuildCreatePayload builds the payload for the transaction create endpoint
 // from CLI flags.
-func BuildCreatePayload(transactionCreateBody string, transactionCreateSourceType string) (*transaction.CreatePayload, error) {
+func BuildCreatePayload(transactionCreateBody string, transactionCreateSourceType string, transactionCreateWait string) (*transaction.CreatePayload, error) {
 	var err error
 	var body CreateRequestBody
 	{
 		err = json.Unmarshal([]byte(transactionCreateBody), &body)
 		if err != nil {
-			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"amount\": \"10.15\",\n      \"state\": \"win\",\n      \"transactionId\": \"some generated identificator\"\n   }'")
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"amount\": \"10.15\",\n      \"currency\": \"EUR\",\n      \"state\": \"win\",\n      \"transactionId\": \"some generated identificator\",\n      \"walletId\": \"0f31adad-bfb6-41d1-aeff-c110ca13cbfa\"\n   }'")
 		}
 		if !(body.State == "win" || body.State == "lost") {
 			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.state", body.State, []any{"win", "lost"}))
 		}
+		err = goa.MergeErrors(err, goa.ValidatePattern("body.currency", body.Currency, "^[A-Z]{3}$"))
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.walletId", body.WalletID, goa.FormatUUID))
 		if err != nil {
 			return nil, err
 		}
@@ -42,12 +45,193 @@ func BuildCreatePayload(transactionCreateBody string, transactionCreateSourceTyp
 			return nil, err
 		}
 	}
+	var wait bool
+	{
+		if transactionCreateWait != "" {
+			wait, err = strconv.ParseBool(transactionCreateWait)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for wait, must be BOOL")
+			}
+		}
+	}
 	v := &transaction.CreatePayload{
 		State:         body.State,
 		Amount:        body.Amount,
+		Currency:      body.Currency,
 		TransactionID: body.TransactionID,
+		WalletID:      body.WalletID,
+	}
+	{
+		var zero string
+		if v.Currency == zero {
+			v.Currency = "EUR"
+		}
+	}
+	v.SourceType = sourceType
+	v.Wait = wait
+
+	return v, nil
+}
+
+// BuildBalancePayload builds the payload for the transaction balance endpoint
+// from CLI flags.
+func BuildBalancePayload(transactionBalanceWalletID string, transactionBalanceAsOf string) (*transaction.BalancePayload, error) {
+	var err error
+	var walletID string
+	{
+		walletID = transactionBalanceWalletID
+		err = goa.MergeErrors(err, goa.ValidateFormat("walletId", walletID, goa.FormatUUID))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var asOf *string
+	{
+		if transactionBalanceAsOf != "" {
+			asOf = &transactionBalanceAsOf
+			err = goa.MergeErrors(err, goa.ValidateFormat("asOf", *asOf, goa.FormatDateTime))
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	v := &transaction.BalancePayload{}
+	v.WalletID = walletID
+	v.AsOf = asOf
+
+	return v, nil
+}
+
+// BuildShowPayload builds the payload for the transaction show endpoint from
+// CLI flags.
+func BuildShowPayload(transactionShowTransactionID string, transactionShowSourceType string) (*transaction.ShowPayload, error) {
+	var err error
+	var transactionID string
+	{
+		transactionID = transactionShowTransactionID
+	}
+	var sourceType string
+	{
+		sourceType = transactionShowSourceType
+		if !(sourceType == "game" || sourceType == "server" || sourceType == "payment" || sourceType == "internal") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("sourceType", sourceType, []any{"game", "server", "payment", "internal"}))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	v := &transaction.ShowPayload{}
+	v.TransactionID = transactionID
+	v.SourceType = sourceType
+
+	return v, nil
+}
+
+// BuildListPayload builds the payload for the transaction list endpoint from
+// CLI flags.
+func BuildListPayload(transactionListWalletID string, transactionListStatus string, transactionListAction string, transactionListSourceType string, transactionListFrom string, transactionListTo string, transactionListCursor string, transactionListLimit string) (*transaction.ListPayload, error) {
+	var err error
+	var walletID *string
+	{
+		if transactionListWalletID != "" {
+			walletID = &transactionListWalletID
+			err = goa.MergeErrors(err, goa.ValidateFormat("walletId", *walletID, goa.FormatUUID))
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var status *string
+	{
+		if transactionListStatus != "" {
+			status = &transactionListStatus
+			if !(*status == "new" || *status == "locked" || *status == "done" || *status == "cancelled") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("status", *status, []any{"new", "locked", "done", "cancelled"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var action *string
+	{
+		if transactionListAction != "" {
+			action = &transactionListAction
+			if !(*action == "win" || *action == "lost") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("action", *action, []any{"win", "lost"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var sourceType *string
+	{
+		if transactionListSourceType != "" {
+			sourceType = &transactionListSourceType
+			if !(*sourceType == "game" || *sourceType == "server" || *sourceType == "payment" || *sourceType == "internal") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("sourceType", *sourceType, []any{"game", "server", "payment", "internal"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var from *string
+	{
+		if transactionListFrom != "" {
+			from = &transactionListFrom
+			err = goa.MergeErrors(err, goa.ValidateFormat("from", *from, goa.FormatDateTime))
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var to *string
+	{
+		if transactionListTo != "" {
+			to = &transactionListTo
+			err = goa.MergeErrors(err, goa.ValidateFormat("to", *to, goa.FormatDateTime))
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var cursor *string
+	{
+		if transactionListCursor != "" {
+			cursor = &transactionListCursor
+		}
+	}
+	var limit int
+	{
+		if transactionListLimit != "" {
+			var v int64
+			v, err = strconv.ParseInt(transactionListLimit, 10, strconv.IntSize)
+			limit = int(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for limit, must be INT")
+			}
+			if limit < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 1, true))
+			}
+			if limit > 500 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 500, false))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
 	}
+	v := &transaction.ListPayload{}
+	v.WalletID = walletID
+	v.Status = status
+	v.Action = action
 	v.SourceType = sourceType
+	v.From = from
+	v.To = to
+	v.Cursor = cursor
+	v.Limit = limit
 
 	return v, nil
 }
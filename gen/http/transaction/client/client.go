@@ -24,6 +24,16 @@ type Client struct {
 	// Create Doer is the HTTP client used to make requests to the create endpoint.
 	CreateDoer goahttp.Doer
 
+	// Balance Doer is the HTTP client used to make requests to the balance
+	// endpoint.
+	BalanceDoer goahttp.Doer
+
+	// Show Doer is the HTTP client used to make requests to the show endpoint.
+	ShowDoer goahttp.Doer
+
+	// List Doer is the HTTP client used to make requests to the list endpoint.
+	ListDoer goahttp.Doer
+
 	// RestoreResponseBody controls whether the response bodies are reset after
 	// decoding so they can be read again.
 	RestoreResponseBody bool
@@ -46,6 +56,9 @@ func NewClient(
 	return &Client{
 		HealthcheckDoer:     doer,
 		CreateDoer:          doer,
+		BalanceDoer:         doer,
+		ShowDoer:            doer,
+		ListDoer:            doer,
 		RestoreResponseBody: restoreBody,
 		scheme:              scheme,
 		host:                host,
@@ -96,3 +109,75 @@ func (c *Client) Create() goa.Endpoint {
 		return decodeResponse(resp)
 	}
 }
+
+// Balance returns an endpoint that makes HTTP requests to the transaction
+// service balance server.
+func (c *Client) Balance() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeBalanceRequest(c.encoder)
+		decodeResponse = DecodeBalanceResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildBalanceRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.BalanceDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("transaction", "balance", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// Show returns an endpoint that makes HTTP requests to the transaction service
+// show server.
+func (c *Client) Show() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeShowRequest(c.encoder)
+		decodeResponse = DecodeShowResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildShowRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ShowDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("transaction", "show", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// List returns an endpoint that makes HTTP requests to the transaction service
+// list server.
+func (c *Client) List() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeListRequest(c.encoder)
+		decodeResponse = DecodeListResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildListRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ListDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("transaction", "list", err)
+		}
+		return decodeResponse(resp)
+	}
+}
@@ -10,9 +10,11 @@ package client
 import (
 	"bytes"
 	"context"
+	"fmt"
 	"io"
 	"net/http"
 	"net/url"
+	"strconv"
 	transaction "wallet/gen/transaction"
 
 	goahttp "goa.design/goa/v3/http"
@@ -36,6 +38,10 @@ func (c *Client) BuildHealthcheckRequest(ctx context.Context, v any) (*http.Requ
 // DecodeHealthcheckResponse returns a decoder for responses returned by the
 // transaction healthcheck endpoint. restoreBody controls whether the response
 // body should be restored after having been read.
+// DecodeHealthcheckResponse may return the following errors:
+//   - "unavailable" (type *goa.ServiceError): http.StatusServiceUnavailable
+//   - "invalid_input" (type *goa.ServiceError): http.StatusBadRequest
+//   - error: internal error
 func DecodeHealthcheckResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
 		if restoreBody {
@@ -66,6 +72,34 @@ func DecodeHealthcheckResponse(decoder func(*http.Response) goahttp.Decoder, res
 			}
 			res := NewHealthcheckResultOK(&body)
 			return res, nil
+		case http.StatusServiceUnavailable:
+			var (
+				body HealthcheckUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("transaction", "healthcheck", err)
+			}
+			err = ValidateHealthcheckUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("transaction", "healthcheck", err)
+			}
+			return nil, NewHealthcheckUnavailable(&body)
+		case http.StatusBadRequest:
+			var (
+				body HealthcheckInvalidInputResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("transaction", "healthcheck", err)
+			}
+			err = ValidateHealthcheckInvalidInputResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("transaction", "healthcheck", err)
+			}
+			return nil, NewHealthcheckInvalidInput(&body)
 		default:
 			body, _ := io.ReadAll(resp.Body)
 			return nil, goahttp.ErrInvalidResponse("transaction", "healthcheck", resp.StatusCode, string(body))
@@ -100,6 +134,11 @@ func EncodeCreateRequest(encoder func(*http.Request) goahttp.Encoder) func(*http
 			head := p.SourceType
 			req.Header.Set("Source-Type", head)
 		}
+		{
+			head := p.Wait
+			headStr := strconv.FormatBool(head)
+			req.Header.Set("X-Wait", headStr)
+		}
 		body := NewCreateRequestBody(p)
 		if err := encoder(req).Encode(&body); err != nil {
 			return goahttp.ErrEncodingError("transaction", "create", err)
@@ -111,6 +150,17 @@ func EncodeCreateRequest(encoder func(*http.Request) goahttp.Encoder) func(*http
 // DecodeCreateResponse returns a decoder for responses returned by the
 // transaction create endpoint. restoreBody controls whether the response body
 // should be restored after having been read.
+// DecodeCreateResponse may return the following errors:
+//   - "invalid_amount" (type *goa.ServiceError): http.StatusBadRequest
+//   - "state_amount_mismatch" (type *goa.ServiceError): http.StatusBadRequest
+//   - "unsupported_currency" (type *goa.ServiceError): http.StatusBadRequest
+//   - "invalid_input" (type *goa.ServiceError): http.StatusBadRequest
+//   - "currency_mismatch" (type *goa.ServiceError): http.StatusConflict
+//   - "duplicate_transaction" (type *goa.ServiceError): http.StatusConflict
+//   - "insufficient_funds" (type *goa.ServiceError): http.StatusConflict
+//   - "reversed" (type *goa.ServiceError): http.StatusConflict
+//   - "unavailable" (type *goa.ServiceError): http.StatusServiceUnavailable
+//   - error: internal error
 func DecodeCreateResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
 		if restoreBody {
@@ -126,11 +176,600 @@ func DecodeCreateResponse(decoder func(*http.Response) goahttp.Decoder, restoreB
 			defer resp.Body.Close()
 		}
 		switch resp.StatusCode {
+		case http.StatusCreated:
+			var (
+				body CreateCreatedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("transaction", "create", err)
+			}
+			res := NewCreateResultCreated(&body)
+			res.Outcome = "processed"
+			return res, nil
+		case http.StatusOK:
+			res := NewCreateResultOK()
+			res.Outcome = "replayed"
+			return res, nil
 		case http.StatusAccepted:
-			return nil, nil
+			res := NewCreateResultAccepted()
+			return res, nil
+		case http.StatusBadRequest:
+			en := resp.Header.Get("goa-error")
+			switch en {
+			case "invalid_amount":
+				var (
+					body CreateInvalidAmountResponseBody
+					err  error
+				)
+				err = decoder(resp).Decode(&body)
+				if err != nil {
+					return nil, goahttp.ErrDecodingError("transaction", "create", err)
+				}
+				err = ValidateCreateInvalidAmountResponseBody(&body)
+				if err != nil {
+					return nil, goahttp.ErrValidationError("transaction", "create", err)
+				}
+				return nil, NewCreateInvalidAmount(&body)
+			case "state_amount_mismatch":
+				var (
+					body CreateStateAmountMismatchResponseBody
+					err  error
+				)
+				err = decoder(resp).Decode(&body)
+				if err != nil {
+					return nil, goahttp.ErrDecodingError("transaction", "create", err)
+				}
+				err = ValidateCreateStateAmountMismatchResponseBody(&body)
+				if err != nil {
+					return nil, goahttp.ErrValidationError("transaction", "create", err)
+				}
+				return nil, NewCreateStateAmountMismatch(&body)
+			case "unsupported_currency":
+				var (
+					body CreateUnsupportedCurrencyResponseBody
+					err  error
+				)
+				err = decoder(resp).Decode(&body)
+				if err != nil {
+					return nil, goahttp.ErrDecodingError("transaction", "create", err)
+				}
+				err = ValidateCreateUnsupportedCurrencyResponseBody(&body)
+				if err != nil {
+					return nil, goahttp.ErrValidationError("transaction", "create", err)
+				}
+				return nil, NewCreateUnsupportedCurrency(&body)
+			case "invalid_input":
+				var (
+					body CreateInvalidInputResponseBody
+					err  error
+				)
+				err = decoder(resp).Decode(&body)
+				if err != nil {
+					return nil, goahttp.ErrDecodingError("transaction", "create", err)
+				}
+				err = ValidateCreateInvalidInputResponseBody(&body)
+				if err != nil {
+					return nil, goahttp.ErrValidationError("transaction", "create", err)
+				}
+				return nil, NewCreateInvalidInput(&body)
+			default:
+				body, _ := io.ReadAll(resp.Body)
+				return nil, goahttp.ErrInvalidResponse("transaction", "create", resp.StatusCode, string(body))
+			}
+		case http.StatusConflict:
+			en := resp.Header.Get("goa-error")
+			switch en {
+			case "currency_mismatch":
+				var (
+					body CreateCurrencyMismatchResponseBody
+					err  error
+				)
+				err = decoder(resp).Decode(&body)
+				if err != nil {
+					return nil, goahttp.ErrDecodingError("transaction", "create", err)
+				}
+				err = ValidateCreateCurrencyMismatchResponseBody(&body)
+				if err != nil {
+					return nil, goahttp.ErrValidationError("transaction", "create", err)
+				}
+				return nil, NewCreateCurrencyMismatch(&body)
+			case "duplicate_transaction":
+				var (
+					body CreateDuplicateTransactionResponseBody
+					err  error
+				)
+				err = decoder(resp).Decode(&body)
+				if err != nil {
+					return nil, goahttp.ErrDecodingError("transaction", "create", err)
+				}
+				err = ValidateCreateDuplicateTransactionResponseBody(&body)
+				if err != nil {
+					return nil, goahttp.ErrValidationError("transaction", "create", err)
+				}
+				return nil, NewCreateDuplicateTransaction(&body)
+			case "insufficient_funds":
+				var (
+					body CreateInsufficientFundsResponseBody
+					err  error
+				)
+				err = decoder(resp).Decode(&body)
+				if err != nil {
+					return nil, goahttp.ErrDecodingError("transaction", "create", err)
+				}
+				err = ValidateCreateInsufficientFundsResponseBody(&body)
+				if err != nil {
+					return nil, goahttp.ErrValidationError("transaction", "create", err)
+				}
+				return nil, NewCreateInsufficientFunds(&body)
+			case "reversed":
+				var (
+					body CreateReversedResponseBody
+					err  error
+				)
+				err = decoder(resp).Decode(&body)
+				if err != nil {
+					return nil, goahttp.ErrDecodingError("transaction", "create", err)
+				}
+				err = ValidateCreateReversedResponseBody(&body)
+				if err != nil {
+					return nil, goahttp.ErrValidationError("transaction", "create", err)
+				}
+				return nil, NewCreateReversed(&body)
+			default:
+				body, _ := io.ReadAll(resp.Body)
+				return nil, goahttp.ErrInvalidResponse("transaction", "create", resp.StatusCode, string(body))
+			}
+		case http.StatusServiceUnavailable:
+			var (
+				body CreateUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("transaction", "create", err)
+			}
+			err = ValidateCreateUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("transaction", "create", err)
+			}
+			return nil, NewCreateUnavailable(&body)
 		default:
 			body, _ := io.ReadAll(resp.Body)
 			return nil, goahttp.ErrInvalidResponse("transaction", "create", resp.StatusCode, string(body))
 		}
 	}
 }
+
+// BuildBalanceRequest instantiates a HTTP request object with method and path
+// set to call the "transaction" service "balance" endpoint
+func (c *Client) BuildBalanceRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		walletID string
+	)
+	{
+		p, ok := v.(*transaction.BalancePayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("transaction", "balance", "*transaction.BalancePayload", v)
+		}
+		walletID = p.WalletID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: BalanceTransactionPath(walletID)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("transaction", "balance", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeBalanceRequest returns an encoder for requests sent to the transaction
+// balance server.
+func EncodeBalanceRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*transaction.BalancePayload)
+		if !ok {
+			return goahttp.ErrInvalidType("transaction", "balance", "*transaction.BalancePayload", v)
+		}
+		values := req.URL.Query()
+		if p.AsOf != nil {
+			values.Add("as_of", *p.AsOf)
+		}
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeBalanceResponse returns a decoder for responses returned by the
+// transaction balance endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeBalanceResponse may return the following errors:
+//   - "unavailable" (type *goa.ServiceError): http.StatusServiceUnavailable
+//   - "invalid_input" (type *goa.ServiceError): http.StatusBadRequest
+//   - error: internal error
+func DecodeBalanceResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body BalanceOKResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("transaction", "balance", err)
+			}
+			err = ValidateBalanceOKResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("transaction", "balance", err)
+			}
+			res := NewBalanceResultOK(&body)
+			return res, nil
+		case http.StatusServiceUnavailable:
+			var (
+				body BalanceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("transaction", "balance", err)
+			}
+			err = ValidateBalanceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("transaction", "balance", err)
+			}
+			return nil, NewBalanceUnavailable(&body)
+		case http.StatusBadRequest:
+			var (
+				body BalanceInvalidInputResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("transaction", "balance", err)
+			}
+			err = ValidateBalanceInvalidInputResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("transaction", "balance", err)
+			}
+			return nil, NewBalanceInvalidInput(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("transaction", "balance", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildShowRequest instantiates a HTTP request object with method and path set
+// to call the "transaction" service "show" endpoint
+func (c *Client) BuildShowRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		transactionID string
+	)
+	{
+		p, ok := v.(*transaction.ShowPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("transaction", "show", "*transaction.ShowPayload", v)
+		}
+		transactionID = p.TransactionID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ShowTransactionPath(transactionID)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("transaction", "show", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeShowRequest returns an encoder for requests sent to the transaction
+// show server.
+func EncodeShowRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*transaction.ShowPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("transaction", "show", "*transaction.ShowPayload", v)
+		}
+		{
+			head := p.SourceType
+			req.Header.Set("Source-Type", head)
+		}
+		return nil
+	}
+}
+
+// DecodeShowResponse returns a decoder for responses returned by the
+// transaction show endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeShowResponse may return the following errors:
+//   - "not_found" (type *goa.ServiceError): http.StatusNotFound
+//   - "unavailable" (type *goa.ServiceError): http.StatusServiceUnavailable
+//   - "invalid_input" (type *goa.ServiceError): http.StatusBadRequest
+//   - error: internal error
+func DecodeShowResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ShowOKResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("transaction", "show", err)
+			}
+			err = ValidateShowOKResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("transaction", "show", err)
+			}
+			res := NewShowTransactionOK(&body)
+			return res, nil
+		case http.StatusNotFound:
+			var (
+				body ShowNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("transaction", "show", err)
+			}
+			err = ValidateShowNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("transaction", "show", err)
+			}
+			return nil, NewShowNotFound(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body ShowUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("transaction", "show", err)
+			}
+			err = ValidateShowUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("transaction", "show", err)
+			}
+			return nil, NewShowUnavailable(&body)
+		case http.StatusBadRequest:
+			var (
+				body ShowInvalidInputResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("transaction", "show", err)
+			}
+			err = ValidateShowInvalidInputResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("transaction", "show", err)
+			}
+			return nil, NewShowInvalidInput(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("transaction", "show", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildListRequest instantiates a HTTP request object with method and path set
+// to call the "transaction" service "list" endpoint
+func (c *Client) BuildListRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListTransactionPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("transaction", "list", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeListRequest returns an encoder for requests sent to the transaction
+// list server.
+func EncodeListRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*transaction.ListPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("transaction", "list", "*transaction.ListPayload", v)
+		}
+		values := req.URL.Query()
+		if p.WalletID != nil {
+			values.Add("walletId", *p.WalletID)
+		}
+		if p.Status != nil {
+			values.Add("status", *p.Status)
+		}
+		if p.Action != nil {
+			values.Add("action", *p.Action)
+		}
+		if p.SourceType != nil {
+			values.Add("sourceType", *p.SourceType)
+		}
+		if p.From != nil {
+			values.Add("from", *p.From)
+		}
+		if p.To != nil {
+			values.Add("to", *p.To)
+		}
+		if p.Cursor != nil {
+			values.Add("cursor", *p.Cursor)
+		}
+		values.Add("limit", fmt.Sprintf("%v", p.Limit))
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeListResponse returns a decoder for responses returned by the
+// transaction list endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeListResponse may return the following errors:
+//   - "invalid_cursor" (type *goa.ServiceError): http.StatusBadRequest
+//   - "invalid_input" (type *goa.ServiceError): http.StatusBadRequest
+//   - "unavailable" (type *goa.ServiceError): http.StatusServiceUnavailable
+//   - error: internal error
+func DecodeListResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ListOKResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("transaction", "list", err)
+			}
+			err = ValidateListOKResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("transaction", "list", err)
+			}
+			res := NewListTransactionListOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			en := resp.Header.Get("goa-error")
+			switch en {
+			case "invalid_cursor":
+				var (
+					body ListInvalidCursorResponseBody
+					err  error
+				)
+				err = decoder(resp).Decode(&body)
+				if err != nil {
+					return nil, goahttp.ErrDecodingError("transaction", "list", err)
+				}
+				err = ValidateListInvalidCursorResponseBody(&body)
+				if err != nil {
+					return nil, goahttp.ErrValidationError("transaction", "list", err)
+				}
+				return nil, NewListInvalidCursor(&body)
+			case "invalid_input":
+				var (
+					body ListInvalidInputResponseBody
+					err  error
+				)
+				err = decoder(resp).Decode(&body)
+				if err != nil {
+					return nil, goahttp.ErrDecodingError("transaction", "list", err)
+				}
+				err = ValidateListInvalidInputResponseBody(&body)
+				if err != nil {
+					return nil, goahttp.ErrValidationError("transaction", "list", err)
+				}
+				return nil, NewListInvalidInput(&body)
+			default:
+				body, _ := io.ReadAll(resp.Body)
+				return nil, goahttp.ErrInvalidResponse("transaction", "list", resp.StatusCode, string(body))
+			}
+		case http.StatusServiceUnavailable:
+			var (
+				body ListUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("transaction", "list", err)
+			}
+			err = ValidateListUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("transaction", "list", err)
+			}
+			return nil, NewListUnavailable(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("transaction", "list", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// unmarshalWorkerHealthResponseBodyToTransactionWorkerHealth builds a value of
+// type *transaction.WorkerHealth from a value of type
+// *WorkerHealthResponseBody.
+func unmarshalWorkerHealthResponseBodyToTransactionWorkerHealth(v *WorkerHealthResponseBody) *transaction.WorkerHealth {
+	if v == nil {
+		return nil
+	}
+	res := &transaction.WorkerHealth{
+		Name:      *v.Name,
+		Status:    *v.Status,
+		Crashes:   *v.Crashes,
+		LastError: v.LastError,
+	}
+
+	return res
+}
+
+// unmarshalTransactionResponseBodyToTransactionTransaction builds a value of
+// type *transaction.Transaction from a value of type *TransactionResponseBody.
+func unmarshalTransactionResponseBodyToTransactionTransaction(v *TransactionResponseBody) *transaction.Transaction {
+	res := &transaction.Transaction{
+		ID:            *v.ID,
+		TransactionID: *v.TransactionID,
+		WalletID:      *v.WalletID,
+		Status:        *v.Status,
+		Amount:        *v.Amount,
+		Currency:      *v.Currency,
+		Action:        *v.Action,
+		SourceType:    *v.SourceType,
+		CancelReason:  v.CancelReason,
+		ReversedBy:    v.ReversedBy,
+		ProcessedAt:   v.ProcessedAt,
+		CreatedAt:     *v.CreatedAt,
+		UpdatedAt:     *v.UpdatedAt,
+	}
+	if v.Reverses != nil {
+		res.Reverses = make([]string, len(v.Reverses))
+		for i, val := range v.Reverses {
+			res.Reverses[i] = val
+		}
+	}
+
+	return res
+}
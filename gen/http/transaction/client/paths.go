@@ -7,6 +7,10 @@
 
 package client
 
+import (
+	"fmt"
+)
+
 // HealthcheckTransactionPath returns the URL path to the transaction service healthcheck HTTP endpoint.
 func HealthcheckTransactionPath() string {
 	return "/transaction/health"
@@ -16,3 +20,18 @@ func HealthcheckTransactionPath() string {
 func CreateTransactionPath() string {
 	return "/transaction"
 }
+
+// BalanceTransactionPath returns the URL path to the transaction service balance HTTP endpoint.
+func BalanceTransactionPath(walletID string) string {
+	return fmt.Sprintf("/transaction/balance/%v", walletID)
+}
+
+// ShowTransactionPath returns the URL path to the transaction service show HTTP endpoint.
+func ShowTransactionPath(transactionID string) string {
+	return fmt.Sprintf("/transaction/%v", transactionID)
+}
+
+// ListTransactionPath returns the URL path to the transaction service list HTTP endpoint.
+func ListTransactionPath() string {
+	return "/transaction"
+}
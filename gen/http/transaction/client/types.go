@@ -20,15 +20,572 @@ type CreateRequestBody struct {
 	State string `form:"state" json:"state" xml:"state"`
 	// Amount of the transaction
 	Amount string `form:"amount" json:"amount" xml:"amount"`
+	// ISO 4217 currency code of the amount, must match the wallet currency
+	Currency string `form:"currency" json:"currency" xml:"currency"`
 	// Transaction ID
 	TransactionID string `form:"transactionId" json:"transactionId" xml:"transactionId"`
+	// Wallet ID
+	WalletID string `form:"walletId" json:"walletId" xml:"walletId"`
 }
 
 // HealthcheckResponseBody is the type of the "transaction" service
 // "healthcheck" endpoint HTTP response body.
 type HealthcheckResponseBody struct {
-	// Service status
+	// Service status, degraded if a worker has failed
 	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// Background workers of the instance
+	Workers []*WorkerHealthResponseBody `form:"workers,omitempty" json:"workers,omitempty" xml:"workers,omitempty"`
+}
+
+// CreateCreatedResponseBody is the type of the "transaction" service "create"
+// endpoint HTTP response body.
+type CreateCreatedResponseBody struct {
+	// Balance of the wallet after the transaction was processed
+	Balance *string `form:"balance,omitempty" json:"balance,omitempty" xml:"balance,omitempty"`
+}
+
+// BalanceOKResponseBody is the type of the "transaction" service "balance"
+// endpoint HTTP response body.
+type BalanceOKResponseBody struct {
+	// Wallet ID
+	WalletID *string `form:"walletId,omitempty" json:"walletId,omitempty" xml:"walletId,omitempty"`
+	// Balance of the wallet
+	Amount *string `form:"amount,omitempty" json:"amount,omitempty" xml:"amount,omitempty"`
+	// ISO 4217 currency code of the wallet
+	Currency *string `form:"currency,omitempty" json:"currency,omitempty" xml:"currency,omitempty"`
+	// Number of transactions in new or locked status which are not included into
+	// the balance yet
+	Pending *int `form:"pending,omitempty" json:"pending,omitempty" xml:"pending,omitempty"`
+	// Point in time of the balance, not set for the current balance
+	AsOf *string `form:"asOf,omitempty" json:"asOf,omitempty" xml:"asOf,omitempty"`
+}
+
+// ShowOKResponseBody is the type of the "transaction" service "show" endpoint
+// HTTP response body.
+type ShowOKResponseBody struct {
+	// Internal ID of the transaction
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Transaction ID given by the source, unique within the source type
+	TransactionID *string `form:"transactionId,omitempty" json:"transactionId,omitempty" xml:"transactionId,omitempty"`
+	// Wallet ID
+	WalletID *string `form:"walletId,omitempty" json:"walletId,omitempty" xml:"walletId,omitempty"`
+	// Processing status of the transaction
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// Amount of the transaction
+	Amount *string `form:"amount,omitempty" json:"amount,omitempty" xml:"amount,omitempty"`
+	// ISO 4217 currency code of the amount
+	Currency *string `form:"currency,omitempty" json:"currency,omitempty" xml:"currency,omitempty"`
+	// Action of the transaction
+	Action *string `form:"action,omitempty" json:"action,omitempty" xml:"action,omitempty"`
+	// Source type of the transaction
+	SourceType *string `form:"sourceType,omitempty" json:"sourceType,omitempty" xml:"sourceType,omitempty"`
+	// Reason the transaction was cancelled for
+	CancelReason *string `form:"cancelReason,omitempty" json:"cancelReason,omitempty" xml:"cancelReason,omitempty"`
+	// Internal ID of the compensating transaction which reversed the cancelled
+	// transaction
+	ReversedBy *string `form:"reversedBy,omitempty" json:"reversedBy,omitempty" xml:"reversedBy,omitempty"`
+	// Internal IDs of the transactions reversed by the compensating transaction
+	Reverses []string `form:"reverses,omitempty" json:"reverses,omitempty" xml:"reverses,omitempty"`
+	// Time the transaction was done or cancelled by the balance worker, balances
+	// as of this time or later include a done transaction
+	ProcessedAt *string `form:"processedAt,omitempty" json:"processedAt,omitempty" xml:"processedAt,omitempty"`
+	// Creation time
+	CreatedAt *string `form:"createdAt,omitempty" json:"createdAt,omitempty" xml:"createdAt,omitempty"`
+	// Last update time
+	UpdatedAt *string `form:"updatedAt,omitempty" json:"updatedAt,omitempty" xml:"updatedAt,omitempty"`
+}
+
+// ListOKResponseBody is the type of the "transaction" service "list" endpoint
+// HTTP response body.
+type ListOKResponseBody struct {
+	// Transactions ordered from the newest to the oldest
+	Transactions []*TransactionResponseBody `form:"transactions,omitempty" json:"transactions,omitempty" xml:"transactions,omitempty"`
+	// Cursor of the next page, absent on the last page
+	NextCursor *string `form:"nextCursor,omitempty" json:"nextCursor,omitempty" xml:"nextCursor,omitempty"`
+}
+
+// HealthcheckUnavailableResponseBody is the type of the "transaction" service
+// "healthcheck" endpoint HTTP response body for the "unavailable" error.
+type HealthcheckUnavailableResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// HealthcheckInvalidInputResponseBody is the type of the "transaction" service
+// "healthcheck" endpoint HTTP response body for the "invalid_input" error.
+type HealthcheckInvalidInputResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// CreateInvalidAmountResponseBody is the type of the "transaction" service
+// "create" endpoint HTTP response body for the "invalid_amount" error.
+type CreateInvalidAmountResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// CreateStateAmountMismatchResponseBody is the type of the "transaction"
+// service "create" endpoint HTTP response body for the "state_amount_mismatch"
+// error.
+type CreateStateAmountMismatchResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// CreateUnsupportedCurrencyResponseBody is the type of the "transaction"
+// service "create" endpoint HTTP response body for the "unsupported_currency"
+// error.
+type CreateUnsupportedCurrencyResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// CreateInvalidInputResponseBody is the type of the "transaction" service
+// "create" endpoint HTTP response body for the "invalid_input" error.
+type CreateInvalidInputResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// CreateCurrencyMismatchResponseBody is the type of the "transaction" service
+// "create" endpoint HTTP response body for the "currency_mismatch" error.
+type CreateCurrencyMismatchResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// CreateDuplicateTransactionResponseBody is the type of the "transaction"
+// service "create" endpoint HTTP response body for the "duplicate_transaction"
+// error.
+type CreateDuplicateTransactionResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// CreateInsufficientFundsResponseBody is the type of the "transaction" service
+// "create" endpoint HTTP response body for the "insufficient_funds" error.
+type CreateInsufficientFundsResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// CreateReversedResponseBody is the type of the "transaction" service "create"
+// endpoint HTTP response body for the "reversed" error.
+type CreateReversedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// CreateUnavailableResponseBody is the type of the "transaction" service
+// "create" endpoint HTTP response body for the "unavailable" error.
+type CreateUnavailableResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// BalanceUnavailableResponseBody is the type of the "transaction" service
+// "balance" endpoint HTTP response body for the "unavailable" error.
+type BalanceUnavailableResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// BalanceInvalidInputResponseBody is the type of the "transaction" service
+// "balance" endpoint HTTP response body for the "invalid_input" error.
+type BalanceInvalidInputResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ShowNotFoundResponseBody is the type of the "transaction" service "show"
+// endpoint HTTP response body for the "not_found" error.
+type ShowNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ShowUnavailableResponseBody is the type of the "transaction" service "show"
+// endpoint HTTP response body for the "unavailable" error.
+type ShowUnavailableResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ShowInvalidInputResponseBody is the type of the "transaction" service "show"
+// endpoint HTTP response body for the "invalid_input" error.
+type ShowInvalidInputResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ListInvalidCursorResponseBody is the type of the "transaction" service
+// "list" endpoint HTTP response body for the "invalid_cursor" error.
+type ListInvalidCursorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ListInvalidInputResponseBody is the type of the "transaction" service "list"
+// endpoint HTTP response body for the "invalid_input" error.
+type ListInvalidInputResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ListUnavailableResponseBody is the type of the "transaction" service "list"
+// endpoint HTTP response body for the "unavailable" error.
+type ListUnavailableResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// WorkerHealthResponseBody is used to define fields on response body types.
+type WorkerHealthResponseBody struct {
+	// Name of the worker
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Status of the worker
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// Number of rounds which failed with an error or a panic since the start
+	Crashes *int64 `form:"crashes,omitempty" json:"crashes,omitempty" xml:"crashes,omitempty"`
+	// Error of the last failed round
+	LastError *string `form:"lastError,omitempty" json:"lastError,omitempty" xml:"lastError,omitempty"`
+}
+
+// CreateBadRequestResponseBody is used to define fields on response body types.
+type CreateBadRequestResponseBody struct {
+	// Outcome of the request
+	Outcome *string `form:"outcome,omitempty" json:"outcome,omitempty" xml:"outcome,omitempty"`
+	// Balance of the wallet after the transaction was processed
+	Balance *string `form:"balance,omitempty" json:"balance,omitempty" xml:"balance,omitempty"`
+}
+
+// CreateInternalServerErrorResponseBody is used to define fields on response
+// body types.
+type CreateInternalServerErrorResponseBody struct {
+	// Outcome of the request
+	Outcome *string `form:"outcome,omitempty" json:"outcome,omitempty" xml:"outcome,omitempty"`
+	// Balance of the wallet after the transaction was processed
+	Balance *string `form:"balance,omitempty" json:"balance,omitempty" xml:"balance,omitempty"`
+}
+
+// BalanceBadRequestResponseBody is used to define fields on response body
+// types.
+type BalanceBadRequestResponseBody struct {
+	// Wallet ID
+	WalletID *string `form:"walletId,omitempty" json:"walletId,omitempty" xml:"walletId,omitempty"`
+	// Balance of the wallet
+	Amount *string `form:"amount,omitempty" json:"amount,omitempty" xml:"amount,omitempty"`
+	// ISO 4217 currency code of the wallet
+	Currency *string `form:"currency,omitempty" json:"currency,omitempty" xml:"currency,omitempty"`
+	// Number of transactions in new or locked status which are not included into
+	// the balance yet
+	Pending *int `form:"pending,omitempty" json:"pending,omitempty" xml:"pending,omitempty"`
+	// Point in time of the balance, not set for the current balance
+	AsOf *string `form:"asOf,omitempty" json:"asOf,omitempty" xml:"asOf,omitempty"`
+}
+
+// BalanceInternalServerErrorResponseBody is used to define fields on response
+// body types.
+type BalanceInternalServerErrorResponseBody struct {
+	// Wallet ID
+	WalletID *string `form:"walletId,omitempty" json:"walletId,omitempty" xml:"walletId,omitempty"`
+	// Balance of the wallet
+	Amount *string `form:"amount,omitempty" json:"amount,omitempty" xml:"amount,omitempty"`
+	// ISO 4217 currency code of the wallet
+	Currency *string `form:"currency,omitempty" json:"currency,omitempty" xml:"currency,omitempty"`
+	// Number of transactions in new or locked status which are not included into
+	// the balance yet
+	Pending *int `form:"pending,omitempty" json:"pending,omitempty" xml:"pending,omitempty"`
+	// Point in time of the balance, not set for the current balance
+	AsOf *string `form:"asOf,omitempty" json:"asOf,omitempty" xml:"asOf,omitempty"`
+}
+
+// ShowInternalServerErrorResponseBody is used to define fields on response
+// body types.
+type ShowInternalServerErrorResponseBody struct {
+	// Internal ID of the transaction
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Transaction ID given by the source, unique within the source type
+	TransactionID *string `form:"transactionId,omitempty" json:"transactionId,omitempty" xml:"transactionId,omitempty"`
+	// Wallet ID
+	WalletID *string `form:"walletId,omitempty" json:"walletId,omitempty" xml:"walletId,omitempty"`
+	// Processing status of the transaction
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// Amount of the transaction
+	Amount *string `form:"amount,omitempty" json:"amount,omitempty" xml:"amount,omitempty"`
+	// ISO 4217 currency code of the amount
+	Currency *string `form:"currency,omitempty" json:"currency,omitempty" xml:"currency,omitempty"`
+	// Action of the transaction
+	Action *string `form:"action,omitempty" json:"action,omitempty" xml:"action,omitempty"`
+	// Source type of the transaction
+	SourceType *string `form:"sourceType,omitempty" json:"sourceType,omitempty" xml:"sourceType,omitempty"`
+	// Reason the transaction was cancelled for
+	CancelReason *string `form:"cancelReason,omitempty" json:"cancelReason,omitempty" xml:"cancelReason,omitempty"`
+	// Internal ID of the compensating transaction which reversed the cancelled
+	// transaction
+	ReversedBy *string `form:"reversedBy,omitempty" json:"reversedBy,omitempty" xml:"reversedBy,omitempty"`
+	// Internal IDs of the transactions reversed by the compensating transaction
+	Reverses []string `form:"reverses,omitempty" json:"reverses,omitempty" xml:"reverses,omitempty"`
+	// Time the transaction was done or cancelled by the balance worker, balances
+	// as of this time or later include a done transaction
+	ProcessedAt *string `form:"processedAt,omitempty" json:"processedAt,omitempty" xml:"processedAt,omitempty"`
+	// Creation time
+	CreatedAt *string `form:"createdAt,omitempty" json:"createdAt,omitempty" xml:"createdAt,omitempty"`
+	// Last update time
+	UpdatedAt *string `form:"updatedAt,omitempty" json:"updatedAt,omitempty" xml:"updatedAt,omitempty"`
+}
+
+// TransactionResponseBody is used to define fields on response body types.
+type TransactionResponseBody struct {
+	// Internal ID of the transaction
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Transaction ID given by the source, unique within the source type
+	TransactionID *string `form:"transactionId,omitempty" json:"transactionId,omitempty" xml:"transactionId,omitempty"`
+	// Wallet ID
+	WalletID *string `form:"walletId,omitempty" json:"walletId,omitempty" xml:"walletId,omitempty"`
+	// Processing status of the transaction
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// Amount of the transaction
+	Amount *string `form:"amount,omitempty" json:"amount,omitempty" xml:"amount,omitempty"`
+	// ISO 4217 currency code of the amount
+	Currency *string `form:"currency,omitempty" json:"currency,omitempty" xml:"currency,omitempty"`
+	// Action of the transaction
+	Action *string `form:"action,omitempty" json:"action,omitempty" xml:"action,omitempty"`
+	// Source type of the transaction
+	SourceType *string `form:"sourceType,omitempty" json:"sourceType,omitempty" xml:"sourceType,omitempty"`
+	// Reason the transaction was cancelled for
+	CancelReason *string `form:"cancelReason,omitempty" json:"cancelReason,omitempty" xml:"cancelReason,omitempty"`
+	// Internal ID of the compensating transaction which reversed the cancelled
+	// transaction
+	ReversedBy *string `form:"reversedBy,omitempty" json:"reversedBy,omitempty" xml:"reversedBy,omitempty"`
+	// Internal IDs of the transactions reversed by the compensating transaction
+	Reverses []string `form:"reverses,omitempty" json:"reverses,omitempty" xml:"reverses,omitempty"`
+	// Time the transaction was done or cancelled by the balance worker, balances
+	// as of this time or later include a done transaction
+	ProcessedAt *string `form:"processedAt,omitempty" json:"processedAt,omitempty" xml:"processedAt,omitempty"`
+	// Creation time
+	CreatedAt *string `form:"createdAt,omitempty" json:"createdAt,omitempty" xml:"createdAt,omitempty"`
+	// Last update time
+	UpdatedAt *string `form:"updatedAt,omitempty" json:"updatedAt,omitempty" xml:"updatedAt,omitempty"`
+}
+
+// ListInternalServerErrorResponseBody is used to define fields on response
+// body types.
+type ListInternalServerErrorResponseBody struct {
+	// Transactions ordered from the newest to the oldest
+	Transactions []*TransactionResponseBody `form:"transactions,omitempty" json:"transactions,omitempty" xml:"transactions,omitempty"`
+	// Cursor of the next page, absent on the last page
+	NextCursor *string `form:"nextCursor,omitempty" json:"nextCursor,omitempty" xml:"nextCursor,omitempty"`
 }
 
 // NewCreateRequestBody builds the HTTP request body from the payload of the
@@ -37,7 +594,15 @@ func NewCreateRequestBody(p *transaction.CreatePayload) *CreateRequestBody {
 	body := &CreateRequestBody{
 		State:         p.State,
 		Amount:        p.Amount,
+		Currency:      p.Currency,
 		TransactionID: p.TransactionID,
+		WalletID:      p.WalletID,
+	}
+	{
+		var zero string
+		if body.Currency == zero {
+			body.Currency = "EUR"
+		}
 	}
 	return body
 }
@@ -48,6 +613,378 @@ func NewHealthcheckResultOK(body *HealthcheckResponseBody) *transaction.Healthch
 	v := &transaction.HealthcheckResult{
 		Status: *body.Status,
 	}
+	if body.Workers != nil {
+		v.Workers = make([]*transaction.WorkerHealth, len(body.Workers))
+		for i, val := range body.Workers {
+			v.Workers[i] = unmarshalWorkerHealthResponseBodyToTransactionWorkerHealth(val)
+		}
+	}
+
+	return v
+}
+
+// NewHealthcheckUnavailable builds a transaction service healthcheck endpoint
+// unavailable error.
+func NewHealthcheckUnavailable(body *HealthcheckUnavailableResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewHealthcheckInvalidInput builds a transaction service healthcheck endpoint
+// invalid_input error.
+func NewHealthcheckInvalidInput(body *HealthcheckInvalidInputResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCreateResultCreated builds a "transaction" service "create" endpoint
+// result from a HTTP "Created" response.
+func NewCreateResultCreated(body *CreateCreatedResponseBody) *transaction.CreateResult {
+	v := &transaction.CreateResult{
+		Balance: body.Balance,
+	}
+
+	return v
+}
+
+// NewCreateResultOK builds a "transaction" service "create" endpoint result
+// from a HTTP "OK" response.
+func NewCreateResultOK() *transaction.CreateResult {
+	v := &transaction.CreateResult{}
+
+	return v
+}
+
+// NewCreateResultAccepted builds a "transaction" service "create" endpoint
+// result from a HTTP "Accepted" response.
+func NewCreateResultAccepted() *transaction.CreateResult {
+	v := &transaction.CreateResult{}
+
+	return v
+}
+
+// NewCreateInvalidAmount builds a transaction service create endpoint
+// invalid_amount error.
+func NewCreateInvalidAmount(body *CreateInvalidAmountResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCreateStateAmountMismatch builds a transaction service create endpoint
+// state_amount_mismatch error.
+func NewCreateStateAmountMismatch(body *CreateStateAmountMismatchResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCreateUnsupportedCurrency builds a transaction service create endpoint
+// unsupported_currency error.
+func NewCreateUnsupportedCurrency(body *CreateUnsupportedCurrencyResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCreateInvalidInput builds a transaction service create endpoint
+// invalid_input error.
+func NewCreateInvalidInput(body *CreateInvalidInputResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCreateCurrencyMismatch builds a transaction service create endpoint
+// currency_mismatch error.
+func NewCreateCurrencyMismatch(body *CreateCurrencyMismatchResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCreateDuplicateTransaction builds a transaction service create endpoint
+// duplicate_transaction error.
+func NewCreateDuplicateTransaction(body *CreateDuplicateTransactionResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCreateInsufficientFunds builds a transaction service create endpoint
+// insufficient_funds error.
+func NewCreateInsufficientFunds(body *CreateInsufficientFundsResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCreateReversed builds a transaction service create endpoint reversed
+// error.
+func NewCreateReversed(body *CreateReversedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCreateUnavailable builds a transaction service create endpoint
+// unavailable error.
+func NewCreateUnavailable(body *CreateUnavailableResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewBalanceResultOK builds a "transaction" service "balance" endpoint result
+// from a HTTP "OK" response.
+func NewBalanceResultOK(body *BalanceOKResponseBody) *transaction.BalanceResult {
+	v := &transaction.BalanceResult{
+		WalletID: *body.WalletID,
+		Amount:   *body.Amount,
+		Currency: *body.Currency,
+		Pending:  *body.Pending,
+		AsOf:     body.AsOf,
+	}
+
+	return v
+}
+
+// NewBalanceUnavailable builds a transaction service balance endpoint
+// unavailable error.
+func NewBalanceUnavailable(body *BalanceUnavailableResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewBalanceInvalidInput builds a transaction service balance endpoint
+// invalid_input error.
+func NewBalanceInvalidInput(body *BalanceInvalidInputResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewShowTransactionOK builds a "transaction" service "show" endpoint result
+// from a HTTP "OK" response.
+func NewShowTransactionOK(body *ShowOKResponseBody) *transaction.Transaction {
+	v := &transaction.Transaction{
+		ID:            *body.ID,
+		TransactionID: *body.TransactionID,
+		WalletID:      *body.WalletID,
+		Status:        *body.Status,
+		Amount:        *body.Amount,
+		Currency:      *body.Currency,
+		Action:        *body.Action,
+		SourceType:    *body.SourceType,
+		CancelReason:  body.CancelReason,
+		ReversedBy:    body.ReversedBy,
+		ProcessedAt:   body.ProcessedAt,
+		CreatedAt:     *body.CreatedAt,
+		UpdatedAt:     *body.UpdatedAt,
+	}
+	if body.Reverses != nil {
+		v.Reverses = make([]string, len(body.Reverses))
+		for i, val := range body.Reverses {
+			v.Reverses[i] = val
+		}
+	}
+
+	return v
+}
+
+// NewShowNotFound builds a transaction service show endpoint not_found error.
+func NewShowNotFound(body *ShowNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewShowUnavailable builds a transaction service show endpoint unavailable
+// error.
+func NewShowUnavailable(body *ShowUnavailableResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewShowInvalidInput builds a transaction service show endpoint invalid_input
+// error.
+func NewShowInvalidInput(body *ShowInvalidInputResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewListTransactionListOK builds a "transaction" service "list" endpoint
+// result from a HTTP "OK" response.
+func NewListTransactionListOK(body *ListOKResponseBody) *transaction.TransactionList {
+	v := &transaction.TransactionList{
+		NextCursor: body.NextCursor,
+	}
+	v.Transactions = make([]*transaction.Transaction, len(body.Transactions))
+	for i, val := range body.Transactions {
+		v.Transactions[i] = unmarshalTransactionResponseBodyToTransactionTransaction(val)
+	}
+
+	return v
+}
+
+// NewListInvalidCursor builds a transaction service list endpoint
+// invalid_cursor error.
+func NewListInvalidCursor(body *ListInvalidCursorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewListInvalidInput builds a transaction service list endpoint invalid_input
+// error.
+func NewListInvalidInput(body *ListInvalidInputResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewListUnavailable builds a transaction service list endpoint unavailable
+// error.
+func NewListUnavailable(body *ListUnavailableResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
 
 	return v
 }
@@ -58,5 +995,836 @@ func ValidateHealthcheckResponseBody(body *HealthcheckResponseBody) (err error)
 	if body.Status == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
 	}
+	if body.Status != nil {
+		if !(*body.Status == "ok" || *body.Status == "degraded") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.status", *body.Status, []any{"ok", "degraded"}))
+		}
+	}
+	for _, e := range body.Workers {
+		if e != nil {
+			if err2 := ValidateWorkerHealthResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateBalanceOKResponseBody runs the validations defined on
+// BalanceOKResponseBody
+func ValidateBalanceOKResponseBody(body *BalanceOKResponseBody) (err error) {
+	if body.WalletID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("walletId", "body"))
+	}
+	if body.Amount == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("amount", "body"))
+	}
+	if body.Currency == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("currency", "body"))
+	}
+	if body.Pending == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("pending", "body"))
+	}
+	if body.AsOf != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.asOf", *body.AsOf, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateShowOKResponseBody runs the validations defined on ShowOKResponseBody
+func ValidateShowOKResponseBody(body *ShowOKResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.TransactionID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("transactionId", "body"))
+	}
+	if body.WalletID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("walletId", "body"))
+	}
+	if body.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
+	}
+	if body.Amount == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("amount", "body"))
+	}
+	if body.Currency == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("currency", "body"))
+	}
+	if body.Action == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("action", "body"))
+	}
+	if body.SourceType == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("sourceType", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("createdAt", "body"))
+	}
+	if body.UpdatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("updatedAt", "body"))
+	}
+	if body.ID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.id", *body.ID, goa.FormatUUID))
+	}
+	if body.Status != nil {
+		if !(*body.Status == "new" || *body.Status == "locked" || *body.Status == "done" || *body.Status == "cancelled") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.status", *body.Status, []any{"new", "locked", "done", "cancelled"}))
+		}
+	}
+	if body.Action != nil {
+		if !(*body.Action == "win" || *body.Action == "lost") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.action", *body.Action, []any{"win", "lost"}))
+		}
+	}
+	if body.SourceType != nil {
+		if !(*body.SourceType == "game" || *body.SourceType == "server" || *body.SourceType == "payment" || *body.SourceType == "internal") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.sourceType", *body.SourceType, []any{"game", "server", "payment", "internal"}))
+		}
+	}
+	if body.CancelReason != nil {
+		if !(*body.CancelReason == "insufficient_funds" || *body.CancelReason == "currency_mismatch" || *body.CancelReason == "reversed") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.cancelReason", *body.CancelReason, []any{"insufficient_funds", "currency_mismatch", "reversed"}))
+		}
+	}
+	if body.ReversedBy != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.reversedBy", *body.ReversedBy, goa.FormatUUID))
+	}
+	for _, e := range body.Reverses {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.reverses[*]", e, goa.FormatUUID))
+	}
+	if body.ProcessedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.processedAt", *body.ProcessedAt, goa.FormatDateTime))
+	}
+	if body.CreatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.createdAt", *body.CreatedAt, goa.FormatDateTime))
+	}
+	if body.UpdatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.updatedAt", *body.UpdatedAt, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateListOKResponseBody runs the validations defined on ListOKResponseBody
+func ValidateListOKResponseBody(body *ListOKResponseBody) (err error) {
+	if body.Transactions == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("transactions", "body"))
+	}
+	for _, e := range body.Transactions {
+		if e != nil {
+			if err2 := ValidateTransactionResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateHealthcheckUnavailableResponseBody runs the validations defined on
+// healthcheck_unavailable_response_body
+func ValidateHealthcheckUnavailableResponseBody(body *HealthcheckUnavailableResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateHealthcheckInvalidInputResponseBody runs the validations defined on
+// healthcheck_invalid_input_response_body
+func ValidateHealthcheckInvalidInputResponseBody(body *HealthcheckInvalidInputResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateCreateInvalidAmountResponseBody runs the validations defined on
+// create_invalid_amount_response_body
+func ValidateCreateInvalidAmountResponseBody(body *CreateInvalidAmountResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateCreateStateAmountMismatchResponseBody runs the validations defined
+// on create_state_amount_mismatch_response_body
+func ValidateCreateStateAmountMismatchResponseBody(body *CreateStateAmountMismatchResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateCreateUnsupportedCurrencyResponseBody runs the validations defined
+// on create_unsupported_currency_response_body
+func ValidateCreateUnsupportedCurrencyResponseBody(body *CreateUnsupportedCurrencyResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateCreateInvalidInputResponseBody runs the validations defined on
+// create_invalid_input_response_body
+func ValidateCreateInvalidInputResponseBody(body *CreateInvalidInputResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateCreateCurrencyMismatchResponseBody runs the validations defined on
+// create_currency_mismatch_response_body
+func ValidateCreateCurrencyMismatchResponseBody(body *CreateCurrencyMismatchResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateCreateDuplicateTransactionResponseBody runs the validations defined
+// on create_duplicate_transaction_response_body
+func ValidateCreateDuplicateTransactionResponseBody(body *CreateDuplicateTransactionResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateCreateInsufficientFundsResponseBody runs the validations defined on
+// create_insufficient_funds_response_body
+func ValidateCreateInsufficientFundsResponseBody(body *CreateInsufficientFundsResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateCreateReversedResponseBody runs the validations defined on
+// create_reversed_response_body
+func ValidateCreateReversedResponseBody(body *CreateReversedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateCreateUnavailableResponseBody runs the validations defined on
+// create_unavailable_response_body
+func ValidateCreateUnavailableResponseBody(body *CreateUnavailableResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateBalanceUnavailableResponseBody runs the validations defined on
+// balance_unavailable_response_body
+func ValidateBalanceUnavailableResponseBody(body *BalanceUnavailableResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateBalanceInvalidInputResponseBody runs the validations defined on
+// balance_invalid_input_response_body
+func ValidateBalanceInvalidInputResponseBody(body *BalanceInvalidInputResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateShowNotFoundResponseBody runs the validations defined on
+// show_not_found_response_body
+func ValidateShowNotFoundResponseBody(body *ShowNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateShowUnavailableResponseBody runs the validations defined on
+// show_unavailable_response_body
+func ValidateShowUnavailableResponseBody(body *ShowUnavailableResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateShowInvalidInputResponseBody runs the validations defined on
+// show_invalid_input_response_body
+func ValidateShowInvalidInputResponseBody(body *ShowInvalidInputResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateListInvalidCursorResponseBody runs the validations defined on
+// list_invalid_cursor_response_body
+func ValidateListInvalidCursorResponseBody(body *ListInvalidCursorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateListInvalidInputResponseBody runs the validations defined on
+// list_invalid_input_response_body
+func ValidateListInvalidInputResponseBody(body *ListInvalidInputResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateListUnavailableResponseBody runs the validations defined on
+// list_unavailable_response_body
+func ValidateListUnavailableResponseBody(body *ListUnavailableResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateWorkerHealthResponseBody runs the validations defined on
+// WorkerHealthResponseBody
+func ValidateWorkerHealthResponseBody(body *WorkerHealthResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
+	}
+	if body.Crashes == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("crashes", "body"))
+	}
+	if body.Status != nil {
+		if !(*body.Status == "running" || *body.Status == "restarting" || *body.Status == "stopped" || *body.Status == "failed") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.status", *body.Status, []any{"running", "restarting", "stopped", "failed"}))
+		}
+	}
+	return
+}
+
+// ValidateCreateBadRequestResponseBody runs the validations defined on
+// CreateBad RequestResponseBody
+func ValidateCreateBadRequestResponseBody(body *CreateBadRequestResponseBody) (err error) {
+	if body.Outcome == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("outcome", "body"))
+	}
+	if body.Outcome != nil {
+		if !(*body.Outcome == "accepted" || *body.Outcome == "processed" || *body.Outcome == "replayed") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.outcome", *body.Outcome, []any{"accepted", "processed", "replayed"}))
+		}
+	}
+	return
+}
+
+// ValidateCreateInternalServerErrorResponseBody runs the validations defined
+// on CreateInternal Server ErrorResponseBody
+func ValidateCreateInternalServerErrorResponseBody(body *CreateInternalServerErrorResponseBody) (err error) {
+	if body.Outcome == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("outcome", "body"))
+	}
+	if body.Outcome != nil {
+		if !(*body.Outcome == "accepted" || *body.Outcome == "processed" || *body.Outcome == "replayed") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.outcome", *body.Outcome, []any{"accepted", "processed", "replayed"}))
+		}
+	}
+	return
+}
+
+// ValidateBalanceBadRequestResponseBody runs the validations defined on
+// BalanceBad RequestResponseBody
+func ValidateBalanceBadRequestResponseBody(body *BalanceBadRequestResponseBody) (err error) {
+	if body.WalletID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("walletId", "body"))
+	}
+	if body.Amount == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("amount", "body"))
+	}
+	if body.Currency == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("currency", "body"))
+	}
+	if body.Pending == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("pending", "body"))
+	}
+	if body.AsOf != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.asOf", *body.AsOf, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateBalanceInternalServerErrorResponseBody runs the validations defined
+// on BalanceInternal Server ErrorResponseBody
+func ValidateBalanceInternalServerErrorResponseBody(body *BalanceInternalServerErrorResponseBody) (err error) {
+	if body.WalletID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("walletId", "body"))
+	}
+	if body.Amount == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("amount", "body"))
+	}
+	if body.Currency == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("currency", "body"))
+	}
+	if body.Pending == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("pending", "body"))
+	}
+	if body.AsOf != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.asOf", *body.AsOf, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateShowInternalServerErrorResponseBody runs the validations defined on
+// ShowInternal Server ErrorResponseBody
+func ValidateShowInternalServerErrorResponseBody(body *ShowInternalServerErrorResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.TransactionID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("transactionId", "body"))
+	}
+	if body.WalletID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("walletId", "body"))
+	}
+	if body.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
+	}
+	if body.Amount == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("amount", "body"))
+	}
+	if body.Currency == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("currency", "body"))
+	}
+	if body.Action == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("action", "body"))
+	}
+	if body.SourceType == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("sourceType", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("createdAt", "body"))
+	}
+	if body.UpdatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("updatedAt", "body"))
+	}
+	if body.ID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.id", *body.ID, goa.FormatUUID))
+	}
+	if body.Status != nil {
+		if !(*body.Status == "new" || *body.Status == "locked" || *body.Status == "done" || *body.Status == "cancelled") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.status", *body.Status, []any{"new", "locked", "done", "cancelled"}))
+		}
+	}
+	if body.Action != nil {
+		if !(*body.Action == "win" || *body.Action == "lost") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.action", *body.Action, []any{"win", "lost"}))
+		}
+	}
+	if body.SourceType != nil {
+		if !(*body.SourceType == "game" || *body.SourceType == "server" || *body.SourceType == "payment" || *body.SourceType == "internal") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.sourceType", *body.SourceType, []any{"game", "server", "payment", "internal"}))
+		}
+	}
+	if body.CancelReason != nil {
+		if !(*body.CancelReason == "insufficient_funds" || *body.CancelReason == "currency_mismatch" || *body.CancelReason == "reversed") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.cancelReason", *body.CancelReason, []any{"insufficient_funds", "currency_mismatch", "reversed"}))
+		}
+	}
+	if body.ReversedBy != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.reversedBy", *body.ReversedBy, goa.FormatUUID))
+	}
+	for _, e := range body.Reverses {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.reverses[*]", e, goa.FormatUUID))
+	}
+	if body.ProcessedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.processedAt", *body.ProcessedAt, goa.FormatDateTime))
+	}
+	if body.CreatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.createdAt", *body.CreatedAt, goa.FormatDateTime))
+	}
+	if body.UpdatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.updatedAt", *body.UpdatedAt, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateTransactionResponseBody runs the validations defined on
+// TransactionResponseBody
+func ValidateTransactionResponseBody(body *TransactionResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.TransactionID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("transactionId", "body"))
+	}
+	if body.WalletID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("walletId", "body"))
+	}
+	if body.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
+	}
+	if body.Amount == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("amount", "body"))
+	}
+	if body.Currency == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("currency", "body"))
+	}
+	if body.Action == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("action", "body"))
+	}
+	if body.SourceType == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("sourceType", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("createdAt", "body"))
+	}
+	if body.UpdatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("updatedAt", "body"))
+	}
+	if body.ID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.id", *body.ID, goa.FormatUUID))
+	}
+	if body.Status != nil {
+		if !(*body.Status == "new" || *body.Status == "locked" || *body.Status == "done" || *body.Status == "cancelled") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.status", *body.Status, []any{"new", "locked", "done", "cancelled"}))
+		}
+	}
+	if body.Action != nil {
+		if !(*body.Action == "win" || *body.Action == "lost") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.action", *body.Action, []any{"win", "lost"}))
+		}
+	}
+	if body.SourceType != nil {
+		if !(*body.SourceType == "game" || *body.SourceType == "server" || *body.SourceType == "payment" || *body.SourceType == "internal") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.sourceType", *body.SourceType, []any{"game", "server", "payment", "internal"}))
+		}
+	}
+	if body.CancelReason != nil {
+		if !(*body.CancelReason == "insufficient_funds" || *body.CancelReason == "currency_mismatch" || *body.CancelReason == "reversed") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.cancelReason", *body.CancelReason, []any{"insufficient_funds", "currency_mismatch", "reversed"}))
+		}
+	}
+	if body.ReversedBy != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.reversedBy", *body.ReversedBy, goa.FormatUUID))
+	}
+	for _, e := range body.Reverses {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.reverses[*]", e, goa.FormatUUID))
+	}
+	if body.ProcessedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.processedAt", *body.ProcessedAt, goa.FormatDateTime))
+	}
+	if body.CreatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.createdAt", *body.CreatedAt, goa.FormatDateTime))
+	}
+	if body.UpdatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.updatedAt", *body.UpdatedAt, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateListInternalServerErrorResponseBody runs the validations defined on
+// ListInternal Server ErrorResponseBody
+func ValidateListInternalServerErrorResponseBody(body *ListInternalServerErrorResponseBody) (err error) {
+	if body.Transactions == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("transactions", "body"))
+	}
+	for _, e := range body.Transactions {
+		if e != nil {
+			if err2 := ValidateTransactionResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
 	return
 }
@@ -21,6 +21,9 @@ type Server struct {
 	Mounts      []*MountPoint
 	Healthcheck http.Handler
 	Create      http.Handler
+	Balance     http.Handler
+	Show        http.Handler
+	List        http.Handler
 }
 
 // MountPoint holds information about the mounted endpoints.
@@ -52,9 +55,15 @@ func New(
 		Mounts: []*MountPoint{
 			{"Healthcheck", "GET", "/transaction/health"},
 			{"Create", "POST", "/transaction"},
+			{"Balance", "GET", "/transaction/balance/{walletId}"},
+			{"Show", "GET", "/transaction/{transactionId}"},
+			{"List", "GET", "/transaction"},
 		},
 		Healthcheck: NewHealthcheckHandler(e.Healthcheck, mux, decoder, encoder, errhandler, formatter),
 		Create:      NewCreateHandler(e.Create, mux, decoder, encoder, errhandler, formatter),
+		Balance:     NewBalanceHandler(e.Balance, mux, decoder, encoder, errhandler, formatter),
+		Show:        NewShowHandler(e.Show, mux, decoder, encoder, errhandler, formatter),
+		List:        NewListHandler(e.List, mux, decoder, encoder, errhandler, formatter),
 	}
 }
 
@@ -65,6 +74,9 @@ func (s *Server) Service() string { return "transaction" }
 func (s *Server) Use(m func(http.Handler) http.Handler) {
 	s.Healthcheck = m(s.Healthcheck)
 	s.Create = m(s.Create)
+	s.Balance = m(s.Balance)
+	s.Show = m(s.Show)
+	s.List = m(s.List)
 }
 
 // MethodNames returns the methods served.
@@ -74,6 +86,9 @@ func (s *Server) MethodNames() []string { return transaction.MethodNames[:] }
 func Mount(mux goahttp.Muxer, h *Server) {
 	MountHealthcheckHandler(mux, h.Healthcheck)
 	MountCreateHandler(mux, h.Create)
+	MountBalanceHandler(mux, h.Balance)
+	MountShowHandler(mux, h.Show)
+	MountListHandler(mux, h.List)
 }
 
 // Mount configures the mux to serve the transaction endpoints.
@@ -105,7 +120,7 @@ func NewHealthcheckHandler(
 ) http.Handler {
 	var (
 		encodeResponse = EncodeHealthcheckResponse(encoder)
-		encodeError    = goahttp.ErrorEncoder(encoder, formatter)
+		encodeError    = EncodeHealthcheckError(encoder, formatter)
 	)
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
@@ -150,7 +165,7 @@ func NewCreateHandler(
 	var (
 		decodeRequest  = DecodeCreateRequest(mux, decoder)
 		encodeResponse = EncodeCreateResponse(encoder)
-		encodeError    = goahttp.ErrorEncoder(encoder, formatter)
+		encodeError    = EncodeCreateError(encoder, formatter)
 	)
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
@@ -175,3 +190,156 @@ func NewCreateHandler(
 		}
 	})
 }
+
+// MountBalanceHandler configures the mux to serve the "transaction" service
+// "balance" endpoint.
+func MountBalanceHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/transaction/balance/{walletId}", f)
+}
+
+// NewBalanceHandler creates a HTTP handler which loads the HTTP request and
+// calls the "transaction" service "balance" endpoint.
+func NewBalanceHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeBalanceRequest(mux, decoder)
+		encodeResponse = EncodeBalanceResponse(encoder)
+		encodeError    = EncodeBalanceError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "balance")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "transaction")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountShowHandler configures the mux to serve the "transaction" service
+// "show" endpoint.
+func MountShowHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/transaction/{transactionId}", f)
+}
+
+// NewShowHandler creates a HTTP handler which loads the HTTP request and calls
+// the "transaction" service "show" endpoint.
+func NewShowHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeShowRequest(mux, decoder)
+		encodeResponse = EncodeShowResponse(encoder)
+		encodeError    = EncodeShowError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "show")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "transaction")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountListHandler configures the mux to serve the "transaction" service
+// "list" endpoint.
+func MountListHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/transaction", f)
+}
+
+// NewListHandler creates a HTTP handler which loads the HTTP request and calls
+// the "transaction" service "list" endpoint.
+func NewListHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeListRequest(mux, decoder)
+		encodeResponse = EncodeListResponse(encoder)
+		encodeError    = EncodeListError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "list")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "transaction")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
@@ -20,15 +20,479 @@ type CreateRequestBody struct {
 	State *string `form:"state,omitempty" json:"state,omitempty" xml:"state,omitempty"`
 	// Amount of the transaction
 	Amount *string `form:"amount,omitempty" json:"amount,omitempty" xml:"amount,omitempty"`
+	// ISO 4217 currency code of the amount, must match the wallet currency
+	Currency *string `form:"currency,omitempty" json:"currency,omitempty" xml:"currency,omitempty"`
 	// Transaction ID
 	TransactionID *string `form:"transactionId,omitempty" json:"transactionId,omitempty" xml:"transactionId,omitempty"`
+	// Wallet ID
+	WalletID *string `form:"walletId,omitempty" json:"walletId,omitempty" xml:"walletId,omitempty"`
 }
 
 // HealthcheckResponseBody is the type of the "transaction" service
 // "healthcheck" endpoint HTTP response body.
 type HealthcheckResponseBody struct {
-	// Service status
+	// Service status, degraded if a worker has failed
 	Status string `form:"status" json:"status" xml:"status"`
+	// Background workers of the instance
+	Workers []*WorkerHealthResponseBody `form:"workers,omitempty" json:"workers,omitempty" xml:"workers,omitempty"`
+}
+
+// CreateCreatedResponseBody is the type of the "transaction" service "create"
+// endpoint HTTP response body.
+type CreateCreatedResponseBody struct {
+	// Balance of the wallet after the transaction was processed
+	Balance *string `form:"balance,omitempty" json:"balance,omitempty" xml:"balance,omitempty"`
+}
+
+// BalanceOKResponseBody is the type of the "transaction" service "balance"
+// endpoint HTTP response body.
+type BalanceOKResponseBody struct {
+	// Wallet ID
+	WalletID string `form:"walletId" json:"walletId" xml:"walletId"`
+	// Balance of the wallet
+	Amount string `form:"amount" json:"amount" xml:"amount"`
+	// ISO 4217 currency code of the wallet
+	Currency string `form:"currency" json:"currency" xml:"currency"`
+	// Number of transactions in new or locked status which are not included into
+	// the balance yet
+	Pending int `form:"pending" json:"pending" xml:"pending"`
+	// Point in time of the balance, not set for the current balance
+	AsOf *string `form:"asOf,omitempty" json:"asOf,omitempty" xml:"asOf,omitempty"`
+}
+
+// ShowOKResponseBody is the type of the "transaction" service "show" endpoint
+// HTTP response body.
+type ShowOKResponseBody struct {
+	// Internal ID of the transaction
+	ID string `form:"id" json:"id" xml:"id"`
+	// Transaction ID given by the source, unique within the source type
+	TransactionID string `form:"transactionId" json:"transactionId" xml:"transactionId"`
+	// Wallet ID
+	WalletID string `form:"walletId" json:"walletId" xml:"walletId"`
+	// Processing status of the transaction
+	Status string `form:"status" json:"status" xml:"status"`
+	// Amount of the transaction
+	Amount string `form:"amount" json:"amount" xml:"amount"`
+	// ISO 4217 currency code of the amount
+	Currency string `form:"currency" json:"currency" xml:"currency"`
+	// Action of the transaction
+	Action string `form:"action" json:"action" xml:"action"`
+	// Source type of the transaction
+	SourceType string `form:"sourceType" json:"sourceType" xml:"sourceType"`
+	// Reason the transaction was cancelled for
+	CancelReason *string `form:"cancelReason,omitempty" json:"cancelReason,omitempty" xml:"cancelReason,omitempty"`
+	// Internal ID of the compensating transaction which reversed the cancelled
+	// transaction
+	ReversedBy *string `form:"reversedBy,omitempty" json:"reversedBy,omitempty" xml:"reversedBy,omitempty"`
+	// Internal IDs of the transactions reversed by the compensating transaction
+	Reverses []string `form:"reverses,omitempty" json:"reverses,omitempty" xml:"reverses,omitempty"`
+	// Time the transaction was done or cancelled by the balance worker, balances
+	// as of this time or later include a done transaction
+	ProcessedAt *string `form:"processedAt,omitempty" json:"processedAt,omitempty" xml:"processedAt,omitempty"`
+	// Creation time
+	CreatedAt string `form:"createdAt" json:"createdAt" xml:"createdAt"`
+	// Last update time
+	UpdatedAt string `form:"updatedAt" json:"updatedAt" xml:"updatedAt"`
+}
+
+// ListOKResponseBody is the type of the "transaction" service "list" endpoint
+// HTTP response body.
+type ListOKResponseBody struct {
+	// Transactions ordered from the newest to the oldest
+	Transactions []*TransactionResponseBody `form:"transactions" json:"transactions" xml:"transactions"`
+	// Cursor of the next page, absent on the last page
+	NextCursor *string `form:"nextCursor,omitempty" json:"nextCursor,omitempty" xml:"nextCursor,omitempty"`
+}
+
+// HealthcheckUnavailableResponseBody is the type of the "transaction" service
+// "healthcheck" endpoint HTTP response body for the "unavailable" error.
+type HealthcheckUnavailableResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// HealthcheckInvalidInputResponseBody is the type of the "transaction" service
+// "healthcheck" endpoint HTTP response body for the "invalid_input" error.
+type HealthcheckInvalidInputResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// CreateInvalidAmountResponseBody is the type of the "transaction" service
+// "create" endpoint HTTP response body for the "invalid_amount" error.
+type CreateInvalidAmountResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// CreateStateAmountMismatchResponseBody is the type of the "transaction"
+// service "create" endpoint HTTP response body for the "state_amount_mismatch"
+// error.
+type CreateStateAmountMismatchResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// CreateUnsupportedCurrencyResponseBody is the type of the "transaction"
+// service "create" endpoint HTTP response body for the "unsupported_currency"
+// error.
+type CreateUnsupportedCurrencyResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// CreateInvalidInputResponseBody is the type of the "transaction" service
+// "create" endpoint HTTP response body for the "invalid_input" error.
+type CreateInvalidInputResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// CreateCurrencyMismatchResponseBody is the type of the "transaction" service
+// "create" endpoint HTTP response body for the "currency_mismatch" error.
+type CreateCurrencyMismatchResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// CreateDuplicateTransactionResponseBody is the type of the "transaction"
+// service "create" endpoint HTTP response body for the "duplicate_transaction"
+// error.
+type CreateDuplicateTransactionResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// CreateInsufficientFundsResponseBody is the type of the "transaction" service
+// "create" endpoint HTTP response body for the "insufficient_funds" error.
+type CreateInsufficientFundsResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// CreateReversedResponseBody is the type of the "transaction" service "create"
+// endpoint HTTP response body for the "reversed" error.
+type CreateReversedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// CreateUnavailableResponseBody is the type of the "transaction" service
+// "create" endpoint HTTP response body for the "unavailable" error.
+type CreateUnavailableResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// BalanceUnavailableResponseBody is the type of the "transaction" service
+// "balance" endpoint HTTP response body for the "unavailable" error.
+type BalanceUnavailableResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// BalanceInvalidInputResponseBody is the type of the "transaction" service
+// "balance" endpoint HTTP response body for the "invalid_input" error.
+type BalanceInvalidInputResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ShowNotFoundResponseBody is the type of the "transaction" service "show"
+// endpoint HTTP response body for the "not_found" error.
+type ShowNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ShowUnavailableResponseBody is the type of the "transaction" service "show"
+// endpoint HTTP response body for the "unavailable" error.
+type ShowUnavailableResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ShowInvalidInputResponseBody is the type of the "transaction" service "show"
+// endpoint HTTP response body for the "invalid_input" error.
+type ShowInvalidInputResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ListInvalidCursorResponseBody is the type of the "transaction" service
+// "list" endpoint HTTP response body for the "invalid_cursor" error.
+type ListInvalidCursorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ListInvalidInputResponseBody is the type of the "transaction" service "list"
+// endpoint HTTP response body for the "invalid_input" error.
+type ListInvalidInputResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ListUnavailableResponseBody is the type of the "transaction" service "list"
+// endpoint HTTP response body for the "unavailable" error.
+type ListUnavailableResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// WorkerHealthResponseBody is used to define fields on response body types.
+type WorkerHealthResponseBody struct {
+	// Name of the worker
+	Name string `form:"name" json:"name" xml:"name"`
+	// Status of the worker
+	Status string `form:"status" json:"status" xml:"status"`
+	// Number of rounds which failed with an error or a panic since the start
+	Crashes int64 `form:"crashes" json:"crashes" xml:"crashes"`
+	// Error of the last failed round
+	LastError *string `form:"lastError,omitempty" json:"lastError,omitempty" xml:"lastError,omitempty"`
+}
+
+// TransactionResponseBody is used to define fields on response body types.
+type TransactionResponseBody struct {
+	// Internal ID of the transaction
+	ID string `form:"id" json:"id" xml:"id"`
+	// Transaction ID given by the source, unique within the source type
+	TransactionID string `form:"transactionId" json:"transactionId" xml:"transactionId"`
+	// Wallet ID
+	WalletID string `form:"walletId" json:"walletId" xml:"walletId"`
+	// Processing status of the transaction
+	Status string `form:"status" json:"status" xml:"status"`
+	// Amount of the transaction
+	Amount string `form:"amount" json:"amount" xml:"amount"`
+	// ISO 4217 currency code of the amount
+	Currency string `form:"currency" json:"currency" xml:"currency"`
+	// Action of the transaction
+	Action string `form:"action" json:"action" xml:"action"`
+	// Source type of the transaction
+	SourceType string `form:"sourceType" json:"sourceType" xml:"sourceType"`
+	// Reason the transaction was cancelled for
+	CancelReason *string `form:"cancelReason,omitempty" json:"cancelReason,omitempty" xml:"cancelReason,omitempty"`
+	// Internal ID of the compensating transaction which reversed the cancelled
+	// transaction
+	ReversedBy *string `form:"reversedBy,omitempty" json:"reversedBy,omitempty" xml:"reversedBy,omitempty"`
+	// Internal IDs of the transactions reversed by the compensating transaction
+	Reverses []string `form:"reverses,omitempty" json:"reverses,omitempty" xml:"reverses,omitempty"`
+	// Time the transaction was done or cancelled by the balance worker, balances
+	// as of this time or later include a done transaction
+	ProcessedAt *string `form:"processedAt,omitempty" json:"processedAt,omitempty" xml:"processedAt,omitempty"`
+	// Creation time
+	CreatedAt string `form:"createdAt" json:"createdAt" xml:"createdAt"`
+	// Last update time
+	UpdatedAt string `form:"updatedAt" json:"updatedAt" xml:"updatedAt"`
 }
 
 // NewHealthcheckResponseBody builds the HTTP response body from the result of
@@ -37,17 +501,396 @@ func NewHealthcheckResponseBody(res *transaction.HealthcheckResult) *Healthcheck
 	body := &HealthcheckResponseBody{
 		Status: res.Status,
 	}
+	if res.Workers != nil {
+		body.Workers = make([]*WorkerHealthResponseBody, len(res.Workers))
+		for i, val := range res.Workers {
+			body.Workers[i] = marshalTransactionWorkerHealthToWorkerHealthResponseBody(val)
+		}
+	}
+	return body
+}
+
+// NewCreateCreatedResponseBody builds the HTTP response body from the result
+// of the "create" endpoint of the "transaction" service.
+func NewCreateCreatedResponseBody(res *transaction.CreateResult) *CreateCreatedResponseBody {
+	body := &CreateCreatedResponseBody{
+		Balance: res.Balance,
+	}
+	return body
+}
+
+// NewBalanceOKResponseBody builds the HTTP response body from the result of
+// the "balance" endpoint of the "transaction" service.
+func NewBalanceOKResponseBody(res *transaction.BalanceResult) *BalanceOKResponseBody {
+	body := &BalanceOKResponseBody{
+		WalletID: res.WalletID,
+		Amount:   res.Amount,
+		Currency: res.Currency,
+		Pending:  res.Pending,
+		AsOf:     res.AsOf,
+	}
+	return body
+}
+
+// NewShowOKResponseBody builds the HTTP response body from the result of the
+// "show" endpoint of the "transaction" service.
+func NewShowOKResponseBody(res *transaction.Transaction) *ShowOKResponseBody {
+	body := &ShowOKResponseBody{
+		ID:            res.ID,
+		TransactionID: res.TransactionID,
+		WalletID:      res.WalletID,
+		Status:        res.Status,
+		Amount:        res.Amount,
+		Currency:      res.Currency,
+		Action:        res.Action,
+		SourceType:    res.SourceType,
+		CancelReason:  res.CancelReason,
+		ReversedBy:    res.ReversedBy,
+		ProcessedAt:   res.ProcessedAt,
+		CreatedAt:     res.CreatedAt,
+		UpdatedAt:     res.UpdatedAt,
+	}
+	if res.Reverses != nil {
+		body.Reverses = make([]string, len(res.Reverses))
+		for i, val := range res.Reverses {
+			body.Reverses[i] = val
+		}
+	}
+	return body
+}
+
+// NewListOKResponseBody builds the HTTP response body from the result of the
+// "list" endpoint of the "transaction" service.
+func NewListOKResponseBody(res *transaction.TransactionList) *ListOKResponseBody {
+	body := &ListOKResponseBody{
+		NextCursor: res.NextCursor,
+	}
+	if res.Transactions != nil {
+		body.Transactions = make([]*TransactionResponseBody, len(res.Transactions))
+		for i, val := range res.Transactions {
+			body.Transactions[i] = marshalTransactionTransactionToTransactionResponseBody(val)
+		}
+	} else {
+		body.Transactions = []*TransactionResponseBody{}
+	}
+	return body
+}
+
+// NewHealthcheckUnavailableResponseBody builds the HTTP response body from the
+// result of the "healthcheck" endpoint of the "transaction" service.
+func NewHealthcheckUnavailableResponseBody(res *goa.ServiceError) *HealthcheckUnavailableResponseBody {
+	body := &HealthcheckUnavailableResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewHealthcheckInvalidInputResponseBody builds the HTTP response body from
+// the result of the "healthcheck" endpoint of the "transaction" service.
+func NewHealthcheckInvalidInputResponseBody(res *goa.ServiceError) *HealthcheckInvalidInputResponseBody {
+	body := &HealthcheckInvalidInputResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCreateInvalidAmountResponseBody builds the HTTP response body from the
+// result of the "create" endpoint of the "transaction" service.
+func NewCreateInvalidAmountResponseBody(res *goa.ServiceError) *CreateInvalidAmountResponseBody {
+	body := &CreateInvalidAmountResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCreateStateAmountMismatchResponseBody builds the HTTP response body from
+// the result of the "create" endpoint of the "transaction" service.
+func NewCreateStateAmountMismatchResponseBody(res *goa.ServiceError) *CreateStateAmountMismatchResponseBody {
+	body := &CreateStateAmountMismatchResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCreateUnsupportedCurrencyResponseBody builds the HTTP response body from
+// the result of the "create" endpoint of the "transaction" service.
+func NewCreateUnsupportedCurrencyResponseBody(res *goa.ServiceError) *CreateUnsupportedCurrencyResponseBody {
+	body := &CreateUnsupportedCurrencyResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCreateInvalidInputResponseBody builds the HTTP response body from the
+// result of the "create" endpoint of the "transaction" service.
+func NewCreateInvalidInputResponseBody(res *goa.ServiceError) *CreateInvalidInputResponseBody {
+	body := &CreateInvalidInputResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCreateCurrencyMismatchResponseBody builds the HTTP response body from the
+// result of the "create" endpoint of the "transaction" service.
+func NewCreateCurrencyMismatchResponseBody(res *goa.ServiceError) *CreateCurrencyMismatchResponseBody {
+	body := &CreateCurrencyMismatchResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCreateDuplicateTransactionResponseBody builds the HTTP response body from
+// the result of the "create" endpoint of the "transaction" service.
+func NewCreateDuplicateTransactionResponseBody(res *goa.ServiceError) *CreateDuplicateTransactionResponseBody {
+	body := &CreateDuplicateTransactionResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCreateInsufficientFundsResponseBody builds the HTTP response body from
+// the result of the "create" endpoint of the "transaction" service.
+func NewCreateInsufficientFundsResponseBody(res *goa.ServiceError) *CreateInsufficientFundsResponseBody {
+	body := &CreateInsufficientFundsResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCreateReversedResponseBody builds the HTTP response body from the result
+// of the "create" endpoint of the "transaction" service.
+func NewCreateReversedResponseBody(res *goa.ServiceError) *CreateReversedResponseBody {
+	body := &CreateReversedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCreateUnavailableResponseBody builds the HTTP response body from the
+// result of the "create" endpoint of the "transaction" service.
+func NewCreateUnavailableResponseBody(res *goa.ServiceError) *CreateUnavailableResponseBody {
+	body := &CreateUnavailableResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewBalanceUnavailableResponseBody builds the HTTP response body from the
+// result of the "balance" endpoint of the "transaction" service.
+func NewBalanceUnavailableResponseBody(res *goa.ServiceError) *BalanceUnavailableResponseBody {
+	body := &BalanceUnavailableResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewBalanceInvalidInputResponseBody builds the HTTP response body from the
+// result of the "balance" endpoint of the "transaction" service.
+func NewBalanceInvalidInputResponseBody(res *goa.ServiceError) *BalanceInvalidInputResponseBody {
+	body := &BalanceInvalidInputResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewShowNotFoundResponseBody builds the HTTP response body from the result of
+// the "show" endpoint of the "transaction" service.
+func NewShowNotFoundResponseBody(res *goa.ServiceError) *ShowNotFoundResponseBody {
+	body := &ShowNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewShowUnavailableResponseBody builds the HTTP response body from the result
+// of the "show" endpoint of the "transaction" service.
+func NewShowUnavailableResponseBody(res *goa.ServiceError) *ShowUnavailableResponseBody {
+	body := &ShowUnavailableResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewShowInvalidInputResponseBody builds the HTTP response body from the
+// result of the "show" endpoint of the "transaction" service.
+func NewShowInvalidInputResponseBody(res *goa.ServiceError) *ShowInvalidInputResponseBody {
+	body := &ShowInvalidInputResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewListInvalidCursorResponseBody builds the HTTP response body from the
+// result of the "list" endpoint of the "transaction" service.
+func NewListInvalidCursorResponseBody(res *goa.ServiceError) *ListInvalidCursorResponseBody {
+	body := &ListInvalidCursorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewListInvalidInputResponseBody builds the HTTP response body from the
+// result of the "list" endpoint of the "transaction" service.
+func NewListInvalidInputResponseBody(res *goa.ServiceError) *ListInvalidInputResponseBody {
+	body := &ListInvalidInputResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewListUnavailableResponseBody builds the HTTP response body from the result
+// of the "list" endpoint of the "transaction" service.
+func NewListUnavailableResponseBody(res *goa.ServiceError) *ListUnavailableResponseBody {
+	body := &ListUnavailableResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
 	return body
 }
 
 // NewCreatePayload builds a transaction service create endpoint payload.
-func NewCreatePayload(body *CreateRequestBody, sourceType string) *transaction.CreatePayload {
+func NewCreatePayload(body *CreateRequestBody, sourceType string, wait bool) *transaction.CreatePayload {
 	v := &transaction.CreatePayload{
 		State:         *body.State,
 		Amount:        *body.Amount,
 		TransactionID: *body.TransactionID,
+		WalletID:      *body.WalletID,
+	}
+	if body.Currency != nil {
+		v.Currency = *body.Currency
+	}
+	if body.Currency == nil {
+		v.Currency = "EUR"
 	}
 	v.SourceType = sourceType
+	v.Wait = wait
+
+	return v
+}
+
+// NewBalancePayload builds a transaction service balance endpoint payload.
+func NewBalancePayload(walletID string, asOf *string) *transaction.BalancePayload {
+	v := &transaction.BalancePayload{}
+	v.WalletID = walletID
+	v.AsOf = asOf
+
+	return v
+}
+
+// NewShowPayload builds a transaction service show endpoint payload.
+func NewShowPayload(transactionID string, sourceType string) *transaction.ShowPayload {
+	v := &transaction.ShowPayload{}
+	v.TransactionID = transactionID
+	v.SourceType = sourceType
+
+	return v
+}
+
+// NewListPayload builds a transaction service list endpoint payload.
+func NewListPayload(walletID *string, status *string, action *string, sourceType *string, from *string, to *string, cursor *string, limit int) *transaction.ListPayload {
+	v := &transaction.ListPayload{}
+	v.WalletID = walletID
+	v.Status = status
+	v.Action = action
+	v.SourceType = sourceType
+	v.From = from
+	v.To = to
+	v.Cursor = cursor
+	v.Limit = limit
 
 	return v
 }
@@ -63,10 +906,19 @@ func ValidateCreateRequestBody(body *CreateRequestBody) (err error) {
 	if body.TransactionID == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("transactionId", "body"))
 	}
+	if body.WalletID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("walletId", "body"))
+	}
 	if body.State != nil {
 		if !(*body.State == "win" || *body.State == "lost") {
 			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.state", *body.State, []any{"win", "lost"}))
 		}
 	}
+	if body.Currency != nil {
+		err = goa.MergeErrors(err, goa.ValidatePattern("body.currency", *body.Currency, "^[A-Z]{3}$"))
+	}
+	if body.WalletID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.walletId", *body.WalletID, goa.FormatUUID))
+	}
 	return
 }
@@ -17,17 +17,27 @@ import (
 type Client struct {
 	HealthcheckEndpoint goa.Endpoint
 	CreateEndpoint      goa.Endpoint
+	BalanceEndpoint     goa.Endpoint
+	ShowEndpoint        goa.Endpoint
+	ListEndpoint        goa.Endpoint
 }
 
 // NewClient initializes a "transaction" service client given the endpoints.
-func NewClient(healthcheck, create goa.Endpoint) *Client {
+func NewClient(healthcheck, create, balance, show, list goa.Endpoint) *Client {
 	return &Client{
 		HealthcheckEndpoint: healthcheck,
 		CreateEndpoint:      create,
+		BalanceEndpoint:     balance,
+		ShowEndpoint:        show,
+		ListEndpoint:        list,
 	}
 }
 
 // Healthcheck calls the "healthcheck" endpoint of the "transaction" service.
+// Healthcheck may return the following errors:
+//   - "unavailable" (type *goa.ServiceError): Storage is temporarily unavailable
+//   - "invalid_input" (type *goa.ServiceError): Identifier or date is malformed
+//   - error: internal error
 func (c *Client) Healthcheck(ctx context.Context) (res *HealthcheckResult, err error) {
 	var ires any
 	ires, err = c.HealthcheckEndpoint(ctx, nil)
@@ -38,7 +48,66 @@ func (c *Client) Healthcheck(ctx context.Context) (res *HealthcheckResult, err e
 }
 
 // Create calls the "create" endpoint of the "transaction" service.
-func (c *Client) Create(ctx context.Context, p *CreatePayload) (err error) {
-	_, err = c.CreateEndpoint(ctx, p)
-	return
+// Create may return the following errors:
+//   - "invalid_amount" (type *goa.ServiceError): Amount is not a decimal number with the currency precision or is too large
+//   - "state_amount_mismatch" (type *goa.ServiceError): Amount sign does not match the state, win amounts must be positive and lost amounts negative
+//   - "unsupported_currency" (type *goa.ServiceError): Currency is not supported
+//   - "currency_mismatch" (type *goa.ServiceError): Currency differs from the wallet currency
+//   - "duplicate_transaction" (type *goa.ServiceError): Transaction with the same ID but a different payload already exists
+//   - "insufficient_funds" (type *goa.ServiceError): Transaction was cancelled because of insufficient funds
+//   - "reversed" (type *goa.ServiceError): Transaction was processed and then cancelled by a correction
+//   - "unavailable" (type *goa.ServiceError): Storage is temporarily unavailable
+//   - "invalid_input" (type *goa.ServiceError): Identifier or date is malformed
+//   - error: internal error
+func (c *Client) Create(ctx context.Context, p *CreatePayload) (res *CreateResult, err error) {
+	var ires any
+	ires, err = c.CreateEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*CreateResult), nil
+}
+
+// Balance calls the "balance" endpoint of the "transaction" service.
+// Balance may return the following errors:
+//   - "unavailable" (type *goa.ServiceError): Storage is temporarily unavailable
+//   - "invalid_input" (type *goa.ServiceError): Identifier or date is malformed
+//   - error: internal error
+func (c *Client) Balance(ctx context.Context, p *BalancePayload) (res *BalanceResult, err error) {
+	var ires any
+	ires, err = c.BalanceEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*BalanceResult), nil
+}
+
+// Show calls the "show" endpoint of the "transaction" service.
+// Show may return the following errors:
+//   - "not_found" (type *goa.ServiceError): Transaction not found
+//   - "unavailable" (type *goa.ServiceError): Storage is temporarily unavailable
+//   - "invalid_input" (type *goa.ServiceError): Identifier or date is malformed
+//   - error: internal error
+func (c *Client) Show(ctx context.Context, p *ShowPayload) (res *Transaction, err error) {
+	var ires any
+	ires, err = c.ShowEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*Transaction), nil
+}
+
+// List calls the "list" endpoint of the "transaction" service.
+// List may return the following errors:
+//   - "invalid_cursor" (type *goa.ServiceError): Cursor cannot be decoded
+//   - "unavailable" (type *goa.ServiceError): Storage is temporarily unavailable
+//   - "invalid_input" (type *goa.ServiceError): Identifier or date is malformed
+//   - error: internal error
+func (c *Client) List(ctx context.Context, p *ListPayload) (res *TransactionList, err error) {
+	var ires any
+	ires, err = c.ListEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*TransactionList), nil
 }
@@ -17,6 +17,9 @@ import (
 type Endpoints struct {
 	Healthcheck goa.Endpoint
 	Create      goa.Endpoint
+	Balance     goa.Endpoint
+	Show        goa.Endpoint
+	List        goa.Endpoint
 }
 
 // NewEndpoints wraps the methods of the "transaction" service with endpoints.
@@ -24,6 +27,9 @@ func NewEndpoints(s Service) *Endpoints {
 	return &Endpoints{
 		Healthcheck: NewHealthcheckEndpoint(s),
 		Create:      NewCreateEndpoint(s),
+		Balance:     NewBalanceEndpoint(s),
+		Show:        NewShowEndpoint(s),
+		List:        NewListEndpoint(s),
 	}
 }
 
@@ -31,6 +37,9 @@ func NewEndpoints(s Service) *Endpoints {
 func (e *Endpoints) Use(m func(goa.Endpoint) goa.Endpoint) {
 	e.Healthcheck = m(e.Healthcheck)
 	e.Create = m(e.Create)
+	e.Balance = m(e.Balance)
+	e.Show = m(e.Show)
+	e.List = m(e.List)
 }
 
 // NewHealthcheckEndpoint returns an endpoint function that calls the method
@@ -46,6 +55,33 @@ func NewHealthcheckEndpoint(s Service) goa.Endpoint {
 func NewCreateEndpoint(s Service) goa.Endpoint {
 	return func(ctx context.Context, req any) (any, error) {
 		p := req.(*CreatePayload)
-		return nil, s.Create(ctx, p)
+		return s.Create(ctx, p)
+	}
+}
+
+// NewBalanceEndpoint returns an endpoint function that calls the method
+// "balance" of service "transaction".
+func NewBalanceEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*BalancePayload)
+		return s.Balance(ctx, p)
+	}
+}
+
+// NewShowEndpoint returns an endpoint function that calls the method "show" of
+// service "transaction".
+func NewShowEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ShowPayload)
+		return s.Show(ctx, p)
+	}
+}
+
+// NewListEndpoint returns an endpoint function that calls the method "list" of
+// service "transaction".
+func NewListEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ListPayload)
+		return s.List(ctx, p)
 	}
 }
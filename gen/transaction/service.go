@@ -9,6 +9,8 @@ package transaction
 
 import (
 	"context"
+
+	goa "goa.design/goa/v3/pkg"
 )
 
 // The transaction service
@@ -16,7 +18,13 @@ type Service interface {
 	// Check if the service is running
 	Healthcheck(context.Context) (res *HealthcheckResult, err error)
 	// Create a new transaction
-	Create(context.Context, *CreatePayload) (err error)
+	Create(context.Context, *CreatePayload) (res *CreateResult, err error)
+	// Retrieve the current balance of a wallet or its balance at a point in time
+	Balance(context.Context, *BalancePayload) (res *BalanceResult, err error)
+	// Retrieve the transaction and its processing status
+	Show(context.Context, *ShowPayload) (res *Transaction, err error)
+	// List transactions page by page, from the newest to the oldest
+	List(context.Context, *ListPayload) (res *TransactionList, err error)
 }
 
 // APIName is the name of the API as defined in the design.
@@ -33,7 +41,31 @@ const ServiceName = "transaction"
 // MethodNames lists the service method names as defined in the design. These
 // are the same values that are set in the endpoint request contexts under the
 // MethodKey key.
-var MethodNames = [2]string{"healthcheck", "create"}
+var MethodNames = [5]string{"healthcheck", "create", "balance", "show", "list"}
+
+// BalancePayload is the payload type of the transaction service balance method.
+type BalancePayload struct {
+	// Wallet ID
+	WalletID string
+	// Return the balance made up of the transactions processed at or before this
+	// time
+	AsOf *string
+}
+
+// BalanceResult is the result type of the transaction service balance method.
+type BalanceResult struct {
+	// Wallet ID
+	WalletID string
+	// Balance of the wallet
+	Amount string
+	// ISO 4217 currency code of the wallet
+	Currency string
+	// Number of transactions in new or locked status which are not included into
+	// the balance yet
+	Pending int
+	// Point in time of the balance, not set for the current balance
+	AsOf *string
+}
 
 // CreatePayload is the payload type of the transaction service create method.
 type CreatePayload struct {
@@ -41,15 +73,168 @@ type CreatePayload struct {
 	State string
 	// Amount of the transaction
 	Amount string
+	// ISO 4217 currency code of the amount, must match the wallet currency
+	Currency string
 	// Transaction ID
 	TransactionID string
+	// Wallet ID
+	WalletID string
 	// Source type header
 	SourceType string
+	// Wait until the transaction is processed
+	Wait bool
+}
+
+// CreateResult is the result type of the transaction service create method.
+type CreateResult struct {
+	// Outcome of the request
+	Outcome string
+	// Balance of the wallet after the transaction was processed
+	Balance *string
 }
 
 // HealthcheckResult is the result type of the transaction service healthcheck
 // method.
 type HealthcheckResult struct {
-	// Service status
+	// Service status, degraded if a worker has failed
 	Status string
+	// Background workers of the instance
+	Workers []*WorkerHealth
+}
+
+// ListPayload is the payload type of the transaction service list method.
+type ListPayload struct {
+	// Wallet ID
+	WalletID *string
+	// Processing status of the transaction
+	Status *string
+	// Action of the transaction
+	Action *string
+	// Source type of the transaction
+	SourceType *string
+	// Include transactions created at or after this time
+	From *string
+	// Include transactions created before this time
+	To *string
+	// Cursor returned with the previous page
+	Cursor *string
+	// Maximum number of transactions in the page
+	Limit int
+}
+
+// ShowPayload is the payload type of the transaction service show method.
+type ShowPayload struct {
+	// Transaction ID given by the source
+	TransactionID string
+	// Source type header
+	SourceType string
+}
+
+// Transaction is the result type of the transaction service show method.
+type Transaction struct {
+	// Internal ID of the transaction
+	ID string
+	// Transaction ID given by the source, unique within the source type
+	TransactionID string
+	// Wallet ID
+	WalletID string
+	// Processing status of the transaction
+	Status string
+	// Amount of the transaction
+	Amount string
+	// ISO 4217 currency code of the amount
+	Currency string
+	// Action of the transaction
+	Action string
+	// Source type of the transaction
+	SourceType string
+	// Reason the transaction was cancelled for
+	CancelReason *string
+	// Internal ID of the compensating transaction which reversed the cancelled
+	// transaction
+	ReversedBy *string
+	// Internal IDs of the transactions reversed by the compensating transaction
+	Reverses []string
+	// Time the transaction was done or cancelled by the balance worker, balances
+	// as of this time or later include a done transaction
+	ProcessedAt *string
+	// Creation time
+	CreatedAt string
+	// Last update time
+	UpdatedAt string
+}
+
+// TransactionList is the result type of the transaction service list method.
+type TransactionList struct {
+	// Transactions ordered from the newest to the oldest
+	Transactions []*Transaction
+	// Cursor of the next page, absent on the last page
+	NextCursor *string
+}
+
+// State of a background worker
+type WorkerHealth struct {
+	// Name of the worker
+	Name string
+	// Status of the worker
+	Status string
+	// Number of rounds which failed with an error or a panic since the start
+	Crashes int64
+	// Error of the last failed round
+	LastError *string
+}
+
+// MakeUnavailable builds a goa.ServiceError from an error.
+func MakeUnavailable(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "unavailable", false, true, false)
+}
+
+// MakeInvalidInput builds a goa.ServiceError from an error.
+func MakeInvalidInput(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "invalid_input", false, false, false)
+}
+
+// MakeInvalidAmount builds a goa.ServiceError from an error.
+func MakeInvalidAmount(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "invalid_amount", false, false, false)
+}
+
+// MakeStateAmountMismatch builds a goa.ServiceError from an error.
+func MakeStateAmountMismatch(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "state_amount_mismatch", false, false, false)
+}
+
+// MakeUnsupportedCurrency builds a goa.ServiceError from an error.
+func MakeUnsupportedCurrency(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "unsupported_currency", false, false, false)
+}
+
+// MakeCurrencyMismatch builds a goa.ServiceError from an error.
+func MakeCurrencyMismatch(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "currency_mismatch", false, false, false)
+}
+
+// MakeDuplicateTransaction builds a goa.ServiceError from an error.
+func MakeDuplicateTransaction(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "duplicate_transaction", false, false, false)
+}
+
+// MakeInsufficientFunds builds a goa.ServiceError from an error.
+func MakeInsufficientFunds(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "insufficient_funds", false, false, false)
+}
+
+// MakeReversed builds a goa.ServiceError from an error.
+func MakeReversed(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "reversed", false, false, false)
+}
+
+// MakeNotFound builds a goa.ServiceError from an error.
+func MakeNotFound(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "not_found", false, false, false)
+}
+
+// MakeInvalidCursor builds a goa.ServiceError from an error.
+func MakeInvalidCursor(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "invalid_cursor", false, false, false)
 }
@@ -2,36 +2,72 @@ package transaction
 
 import (
 	"errors"
+	"github.com/google/uuid"
 	"wallet/transaction/internal/domain/entities"
 	"wallet/transaction/internal/domain/repositories"
+	"wallet/transaction/internal/domain/services"
 	"wallet/transaction/internal/domain/vo"
 )
 
-// AddTransaction represents a transaction to be added, including source type, action, amount, and an identifier.
+// AddTransaction represents a transaction to be added, including wallet, source type, action, amount, currency
+// and an identifier given by the source, which is unique within the source type. The trace context of the request
+// is stored with the transaction.
 type AddTransaction struct {
-	SourceType string
-	Action     string
-	Amount     vo.Amount
-	ID         string
+	WalletID    uuid.UUID
+	SourceType  string
+	Action      string
+	Amount      vo.Amount
+	Currency    vo.Currency
+	ID          string
+	TraceParent *string
 }
 
-// TransactionStorage defines an interface for storing transactions with a method to create a new transaction.
+// ErrDuplicateTransaction is returned when a transaction with the same ID but a different payload already exists.
+var ErrDuplicateTransaction = errors.New("transaction with the same ID but a different payload already exists")
+
+// TransactionStorage defines an interface for storing transactions with methods to create a new transaction,
+// find a transaction by its source type and external ID and find the wallet currency.
 type TransactionStorage interface {
 	Create(transaction *entities.Transaction) error
+	FindByExternalID(sourceType string, externalID string) (*entities.Transaction, error)
+	FindWalletCurrency(walletID uuid.UUID) (*vo.Currency, error)
 }
 
 // Execute creates and stores a new transaction using the provided TransactionStorage repository,
-// skipping if the amount is zero.
-func (a *AddTransaction) Execute(repo TransactionStorage) error {
+// skipping if the amount is zero and rejecting it if the currency differs from the wallet currency.
+// It returns true if the same transaction was already stored and ErrDuplicateTransaction if a transaction
+// with the same ID but a different payload was stored.
+func (a *AddTransaction) Execute(repo TransactionStorage) (bool, error) {
 	if a.Amount.Equal(vo.NewAmount(0)) {
-		return nil
+		return false, nil
+	}
+
+	walletCurrency, err := repo.FindWalletCurrency(a.WalletID)
+	if err != nil {
+		return false, err
 	}
-	transaction := entities.NewTransaction(a.ID, a.Amount, a.Action, a.SourceType)
+	if walletCurrency != nil && *walletCurrency != a.Currency {
+		return false, services.ErrCurrencyMismatch
+	}
+
+	transaction := entities.NewTransaction(a.ID, a.WalletID, a.Amount, a.Currency, a.Action, a.SourceType)
+	transaction.TraceParent = a.TraceParent
 
-	err := repo.Create(transaction)
-	if err != nil && !errors.Is(err, repositories.ErrDuplicateKey) {
-		return err
+	err = repo.Create(transaction)
+	if err == nil {
+		return false, nil
+	}
+	if !errors.Is(err, repositories.ErrDuplicateKey) {
+		return false, err
+	}
+
+	existed, err := repo.FindByExternalID(a.SourceType, a.ID)
+	if err != nil {
+		return false, err
+	}
+	if !existed.SamePayload(transaction) {
+		return false, ErrDuplicateTransaction
 	}
 
-	return nil
+	return true, nil
 }
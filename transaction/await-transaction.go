@@ -0,0 +1,49 @@
+package transaction
+
+import (
+	"context"
+	"errors"
+	"gorm.io/gorm"
+	"time"
+	"wallet/transaction/internal/domain/entities"
+)
+
+// AwaitTransaction represents a request to wait until the transaction is processed by the balance worker.
+type AwaitTransaction struct {
+	SourceType   string
+	ID           string
+	Timeout      time.Duration
+	PollInterval time.Duration
+}
+
+// TransactionFinder defines an interface for finding a transaction by its source type and external ID.
+type TransactionFinder interface {
+	FindByExternalID(sourceType string, externalID string) (*entities.Transaction, error)
+}
+
+// Execute polls the transaction until it is done or cancelled and returns it, returns nil if the transaction
+// was not processed within the timeout.
+func (a *AwaitTransaction) Execute(ctx context.Context, repo TransactionFinder) (*entities.Transaction, error) {
+	ctx, cancel := context.WithTimeout(ctx, a.Timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(a.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		transaction, err := repo.FindByExternalID(a.SourceType, a.ID)
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, err
+		}
+
+		if transaction != nil && transaction.IsProcessed() {
+			return transaction, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, nil
+		case <-ticker.C:
+		}
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"flag"
 	"fmt"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/spf13/viper"
 	"gorm.io/gorm"
 	"net/url"
 	"os"
@@ -13,11 +15,16 @@ import (
 	"wallet/config"
 	"wallet/transaction/interfaces"
 	"wallet/transaction/interfaces/http"
+	"wallet/transaction/internal/domain/repositories"
+	"wallet/transaction/internal/domain/services"
 	"wallet/transaction/internal/infrastructure/db"
+	"wallet/transaction/internal/infrastructure/metrics"
+	"wallet/transaction/internal/infrastructure/tracing"
 	"wallet/transaction/workers"
 
 	"goa.design/clue/debug"
 	"goa.design/clue/log"
+	"wallet/gen/correction"
 	"wallet/gen/transaction"
 )
 
@@ -39,29 +46,71 @@ func main() {
 	config.Load()
 
 	//Initialize db
-	var gormdb *gorm.DB
+	var (
+		gormdb       *gorm.DB
+		dbConnection db.DbConnection
+	)
 	{
-		dbConnection := db.NewConnection()
+		dbConnection = db.NewConnection()
 		gormdb = dbConnection.Connect(ctx)
-		err := db.RunAutoMigrations(gormdb)
+	}
+
+	switch flag.Arg(0) {
+	case "migrate":
+		migrate(ctx, gormdb, flag.Args()[1:])
+		return
+	case "reconcile":
+		reconcile(ctx, gormdb, flag.Args()[1:])
+		return
+	}
+
+	if viper.GetBool("db.migrate_on_start") {
+		applied, err := db.NewMigrator(gormdb).Up()
 		if err != nil {
-			panic(err)
+			log.Fatalf(ctx, err, "cannot migrate database")
+		}
+		for _, migration := range applied {
+			log.Printf(ctx, "Applied migration %d_%s", migration.Version, migration.Name)
 		}
 	}
 
+	flushSpans, err := tracing.Setup(ctx, viper.GetString("tracing.exporter"), viper.GetString("tracing.otlp_endpoint"), viper.GetInt("tracing.max_sampling_rate"))
+	if err != nil {
+		log.Fatalf(ctx, err, "cannot set up tracing")
+	}
+	defer flushSpans()
+
+	correctionPolicy, err := services.LoadCorrectionPolicy()
+	if err != nil {
+		log.Fatalf(ctx, err, "invalid correction policy")
+	}
+
 	// Initialize the services.
-	var txSvc transaction.Service
+	var (
+		txSvc         transaction.Service
+		correctionSvc correction.Service
+	)
 	{
 		txSvc = interfaces.NewTxController(gormdb)
+		correctionSvc = interfaces.NewCorrectionController(gormdb)
 	}
 
+	// The gauges reflecting the database state are read when the metrics are scraped.
+	prometheus.MustRegister(metrics.NewStateCollector(interfaces.NewMetricsState(gormdb)))
+
 	// Wrap the services in endpoints that can be invoked from other services
 	// potentially running in different processes.
-	var txEndpoints *transaction.Endpoints
+	var (
+		txEndpoints         *transaction.Endpoints
+		correctionEndpoints *correction.Endpoints
+	)
 	{
 		txEndpoints = transaction.NewEndpoints(txSvc)
 		txEndpoints.Use(debug.LogPayloads())
 		txEndpoints.Use(log.Endpoint)
+		correctionEndpoints = correction.NewEndpoints(correctionSvc)
+		correctionEndpoints.Use(debug.LogPayloads())
+		correctionEndpoints.Use(log.Endpoint)
 	}
 
 	// Create channel used by both the signal handler and server goroutines
@@ -80,8 +129,18 @@ func main() {
 	ctx, cancel := context.WithCancel(ctx)
 
 	{
-		workers.RunBalanceWorker(ctx, gormdb)
-		workers.RunCorrectionWorker(ctx, gormdb)
+		pollInterval := viper.GetDuration("worker.poll_interval")
+		maxPollInterval := viper.GetDuration("worker.max_poll_interval")
+		restartDelay := viper.GetDuration("worker.restart_delay")
+		maxRestartDelay := viper.GetDuration("worker.max_restart_delay")
+		newTransactions := dbConnection.Listen(ctx, repositories.NewTransactionsChannel)
+
+		workers.RunBalanceWorker(ctx, gormdb, viper.GetInt("balance.batch_size"), workers.NewBackoff(newTransactions, pollInterval, maxPollInterval),
+			workers.NewSupervisor("balance", restartDelay, maxRestartDelay), &wg)
+		workers.RunCorrectionWorker(ctx, gormdb, correctionPolicy, workers.NewBackoff(nil, pollInterval, maxPollInterval),
+			workers.NewSupervisor("correction", restartDelay, maxRestartDelay), &wg)
+		workers.RunSnapshotWorker(ctx, gormdb, viper.GetDuration("snapshot.interval"), &wg)
+		workers.RunReconciliationWorker(ctx, gormdb, viper.GetDuration("reconciliation.interval"), viper.GetBool("reconciliation.auto_repair"), &wg)
 	}
 
 	{
@@ -90,7 +149,7 @@ func main() {
 		if err != nil {
 			log.Fatalf(ctx, err, "invalid URL %#v\n", addr)
 		}
-		http.HandleHTTPServer(ctx, u, txEndpoints, &wg, errc, *dbgF)
+		http.HandleHTTPServer(ctx, u, txEndpoints, correctionEndpoints, &wg, errc, *dbgF)
 	}
 
 	// Wait for signal.
@@ -0,0 +1,61 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"goa.design/clue/log"
+	"gorm.io/gorm"
+	"wallet/transaction/internal/infrastructure/db"
+)
+
+// migrate applies, reverts or lists the database migrations:
+//
+//	migrate up              applies all pending migrations
+//	migrate down [-steps N] reverts the last N applied migrations (default: 1)
+//	migrate status          lists the migrations and whether they are applied
+func migrate(ctx context.Context, gormdb *gorm.DB, args []string) {
+	flags := flag.NewFlagSet("migrate", flag.ExitOnError)
+	steps := flags.Int("steps", 1, "Number of migrations to revert")
+	command := "up"
+	if len(args) > 0 {
+		command = args[0]
+		_ = flags.Parse(args[1:])
+	}
+
+	migrator := db.NewMigrator(gormdb)
+	switch command {
+	case "up":
+		applied, err := migrator.Up()
+		if err != nil {
+			log.Fatalf(ctx, err, "cannot apply migrations")
+		}
+		for _, migration := range applied {
+			fmt.Printf("applied %d_%s\n", migration.Version, migration.Name)
+		}
+		fmt.Printf("%d migrations applied\n", len(applied))
+	case "down":
+		reverted, err := migrator.Down(*steps)
+		if err != nil {
+			log.Fatalf(ctx, err, "cannot revert migrations")
+		}
+		for _, migration := range reverted {
+			fmt.Printf("reverted %d_%s\n", migration.Version, migration.Name)
+		}
+		fmt.Printf("%d migrations reverted\n", len(reverted))
+	case "status":
+		statuses, err := migrator.Status()
+		if err != nil {
+			log.Fatalf(ctx, err, "cannot read migrations")
+		}
+		for _, status := range statuses {
+			state := "pending"
+			if status.Applied {
+				state = "applied"
+			}
+			fmt.Printf("%d_%s %s\n", status.Version, status.Name, state)
+		}
+	default:
+		log.Fatalf(ctx, fmt.Errorf("unknown migrate command %q", command), "usage: migrate up|down [-steps N]|status")
+	}
+}
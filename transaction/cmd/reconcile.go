@@ -0,0 +1,38 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"github.com/spf13/viper"
+	"goa.design/clue/log"
+	"gorm.io/gorm"
+	"os"
+	"wallet/transaction/workers"
+)
+
+// reconcile runs the balance reconciliation of all wallets once and prints the detected discrepancies,
+// exits with non-zero status if any discrepancy was found.
+func reconcile(ctx context.Context, db *gorm.DB, args []string) {
+	flags := flag.NewFlagSet("reconcile", flag.ExitOnError)
+	repair := flags.Bool("repair", viper.GetBool("reconciliation.auto_repair"), "Overwrite the differing balances with the sum of the transactions")
+	_ = flags.Parse(args)
+
+	discrepancies, err := workers.NewReconciliationWorker(db, *repair).Execute()
+	for _, discrepancy := range discrepancies {
+		fmt.Printf("wallet %s: stored %s, calculated %s, repaired: %t\n",
+			discrepancy.WalletID,
+			discrepancy.Stored.Format(discrepancy.Currency),
+			discrepancy.Calculated.Format(discrepancy.Currency),
+			discrepancy.Repaired,
+		)
+	}
+	if err != nil {
+		log.Fatalf(ctx, err, "cannot reconcile balances")
+	}
+
+	fmt.Printf("%d discrepancies found\n", len(discrepancies))
+	if len(discrepancies) > 0 {
+		os.Exit(1)
+	}
+}
@@ -3,51 +3,349 @@ package interfaces
 import (
 	"context"
 	"errors"
+	"github.com/google/uuid"
+	"github.com/spf13/viper"
 	"gorm.io/gorm"
+	"time"
 	balancesvc "wallet/gen/transaction"
 	txsvc "wallet/gen/transaction"
 	"wallet/transaction"
+	"wallet/transaction/internal/domain/entities"
 	"wallet/transaction/internal/domain/repositories"
+	"wallet/transaction/internal/domain/services"
 	"wallet/transaction/internal/domain/vo"
+	"wallet/transaction/internal/infrastructure/metrics"
+	"wallet/transaction/internal/infrastructure/tracing"
+	"wallet/transaction/workers"
 )
 
 type txController struct {
-	repo *repositories.TransactionRepository
+	repo             *repositories.TransactionRepository
+	balanceProvider  services.BalanceProvider
+	balanceHistory   services.BalanceHistory
+	syncTimeout      time.Duration
+	syncPollInterval time.Duration
 }
 
-func (t txController) Create(ctx context.Context, payload *balancesvc.CreatePayload) error {
-	amount, err := vo.NewAmountFromString(payload.Amount)
+func (t txController) Create(ctx context.Context, payload *balancesvc.CreatePayload) (*balancesvc.CreateResult, error) {
+	currency, err := vo.NewCurrency(payload.Currency)
 	if err != nil {
-		return err
+		return nil, balancesvc.MakeUnsupportedCurrency(err)
+	}
+
+	amount, err := vo.NewAmountFromString(payload.Amount, currency)
+	if err != nil {
+		return nil, balancesvc.MakeInvalidAmount(err)
 	}
 
 	if amount.LessThenZero() && payload.State == "win" {
-		return errors.New("win amount must be greater than zero")
+		return nil, balancesvc.MakeStateAmountMismatch(errors.New("win amount must be greater than zero"))
 	}
 	if amount.GreaterThenZero() && payload.State == "lost" {
-		return errors.New("win amount must be greater than zero")
+		return nil, balancesvc.MakeStateAmountMismatch(errors.New("lost amount must be less than zero"))
+	}
+
+	walletID, err := uuid.Parse(payload.WalletID)
+	if err != nil {
+		return nil, balancesvc.MakeInvalidInput(err)
 	}
 
 	command := transaction.AddTransaction{
-		SourceType: payload.SourceType,
-		Action:     payload.State,
-		Amount:     amount,
-		ID:         payload.TransactionID,
+		WalletID:    walletID,
+		SourceType:  payload.SourceType,
+		Action:      payload.State,
+		Amount:      amount,
+		Currency:    currency,
+		ID:          payload.TransactionID,
+		TraceParent: tracing.TraceParent(ctx),
+	}
+
+	repo := t.repo.WithContext(ctx)
+	labels := metrics.TransactionLabels(payload.SourceType, payload.State)
+	replayed, err := command.Execute(repo)
+	if err != nil {
+		if errors.Is(err, services.ErrCurrencyMismatch) {
+			return nil, balancesvc.MakeCurrencyMismatch(err)
+		}
+		if errors.Is(err, transaction.ErrDuplicateTransaction) {
+			metrics.TransactionsDuplicated.With(labels).Inc()
+			return nil, balancesvc.MakeDuplicateTransaction(err)
+		}
+		return nil, storageError(err, balancesvc.MakeUnavailable)
+	}
+
+	if replayed {
+		metrics.TransactionsDuplicated.With(labels).Inc()
+		return &balancesvc.CreateResult{Outcome: "replayed"}, nil
+	}
+	metrics.TransactionsCreated.With(labels).Inc()
+
+	// the workers poll for new transactions anyway, so a lost notification only delays the processing
+	_ = repo.NotifyNew(walletID)
+
+	accepted := &balancesvc.CreateResult{Outcome: "accepted"}
+	if !payload.Wait || amount.IsZero() {
+		return accepted, nil
+	}
+
+	return t.await(ctx, payload.SourceType, payload.TransactionID, walletID, accepted)
+}
+
+// await waits until the transaction is processed, returns accepted result if it was not processed in time.
+func (t txController) await(ctx context.Context, sourceType string, id string, walletID uuid.UUID, accepted *balancesvc.CreateResult) (*balancesvc.CreateResult, error) {
+	command := transaction.AwaitTransaction{
+		SourceType:   sourceType,
+		ID:           id,
+		Timeout:      t.syncTimeout,
+		PollInterval: t.syncPollInterval,
+	}
+
+	tx, err := command.Execute(ctx, t.repo.WithContext(ctx))
+	if err != nil {
+		return nil, storageError(err, balancesvc.MakeUnavailable)
+	}
+
+	if tx == nil {
+		return accepted, nil
+	}
+
+	if tx.Status == entities.Cancelled {
+		return nil, cancellationError(tx)
+	}
+
+	balance, err := t.balanceProvider.WithContext(ctx).Current(walletID)
+	if err != nil {
+		return nil, storageError(err, balancesvc.MakeUnavailable)
+	}
+	balanceValue := balance.Value.Format(balance.Currency)
+
+	return &balancesvc.CreateResult{Outcome: "processed", Balance: &balanceValue}, nil
+}
+
+// cancellationError returns the error describing the reason the transaction was cancelled for.
+func cancellationError(tx *entities.Transaction) error {
+	if tx.CancelReason == nil {
+		return balancesvc.MakeInsufficientFunds(services.ErrNegativeBalance)
+	}
+
+	switch *tx.CancelReason {
+	case entities.CurrencyMismatch:
+		return balancesvc.MakeCurrencyMismatch(services.ErrCurrencyMismatch)
+	case entities.Reversed:
+		return balancesvc.MakeReversed(errors.New("transaction was reversed by a correction"))
+	default:
+		return balancesvc.MakeInsufficientFunds(services.ErrNegativeBalance)
+	}
+}
+
+func (t txController) Balance(ctx context.Context, payload *balancesvc.BalancePayload) (*balancesvc.BalanceResult, error) {
+	walletID, err := uuid.Parse(payload.WalletID)
+	if err != nil {
+		return nil, balancesvc.MakeInvalidInput(err)
+	}
+
+	var balance *entities.Balance
+	if payload.AsOf != nil {
+		asOf, err := time.Parse(time.RFC3339, *payload.AsOf)
+		if err != nil {
+			return nil, balancesvc.MakeInvalidInput(err)
+		}
+		balance, err = t.balanceHistory.WithContext(ctx).At(walletID, asOf)
+	} else {
+		balance, err = t.balanceProvider.WithContext(ctx).Current(walletID)
+	}
+	if err != nil {
+		return nil, storageError(err, balancesvc.MakeUnavailable)
+	}
+
+	pending, err := t.repo.WithContext(ctx).CountPendingTransactions(walletID)
+	if err != nil {
+		return nil, storageError(err, balancesvc.MakeUnavailable)
+	}
+
+	res := balancesvc.BalanceResult{
+		WalletID: walletID.String(),
+		Amount:   balance.Value.Format(balance.Currency),
+		Currency: string(balance.Currency),
+		Pending:  int(pending),
+		AsOf:     payload.AsOf,
+	}
+
+	return &res, nil
+}
+
+func (t txController) Show(ctx context.Context, payload *balancesvc.ShowPayload) (*balancesvc.Transaction, error) {
+	repo := t.repo.WithContext(ctx)
+	tx, err := repo.FindByExternalID(payload.SourceType, payload.TransactionID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, balancesvc.MakeNotFound(err)
+		}
+		return nil, storageError(err, balancesvc.MakeUnavailable)
+	}
+
+	reversed, err := findReversed(repo, []entities.Transaction{*tx})
+	if err != nil {
+		return nil, storageError(err, balancesvc.MakeUnavailable)
 	}
 
-	return command.Execute(t.repo)
+	return toTransactionResult(tx, reversed[tx.ID]), nil
 }
 
+func (t txController) List(ctx context.Context, payload *balancesvc.ListPayload) (*balancesvc.TransactionList, error) {
+	filter, err := toTransactionFilter(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	// one more transaction is requested to find out if there is a next page
+	filter.Limit = payload.Limit + 1
+	repo := t.repo.WithContext(ctx)
+	transactions, err := repo.List(filter)
+	if err != nil {
+		return nil, storageError(err, balancesvc.MakeUnavailable)
+	}
+
+	res := balancesvc.TransactionList{
+		Transactions: make([]*balancesvc.Transaction, 0, len(transactions)),
+	}
+	if len(transactions) > payload.Limit {
+		transactions = transactions[:payload.Limit]
+		last := transactions[len(transactions)-1]
+		cursor := repositories.Cursor{CreatedAt: last.CreatedAt, ID: last.ID}.String()
+		res.NextCursor = &cursor
+	}
+
+	reversed, err := findReversed(repo, transactions)
+	if err != nil {
+		return nil, storageError(err, balancesvc.MakeUnavailable)
+	}
+
+	for _, tx := range transactions {
+		res.Transactions = append(res.Transactions, toTransactionResult(&tx, reversed[tx.ID]))
+	}
+
+	return &res, nil
+}
+
+// findReversed returns the transactions reversed by the given compensating transactions, only internal transactions
+// are looked up.
+func findReversed(repo *repositories.TransactionRepository, transactions []entities.Transaction) (map[uuid.UUID][]entities.Transaction, error) {
+	var compensationIDs []uuid.UUID
+	for _, tx := range transactions {
+		if tx.IsInternal() {
+			compensationIDs = append(compensationIDs, tx.ID)
+		}
+	}
+
+	return repo.FindReversedBy(compensationIDs)
+}
+
+// Healthcheck reports the state of the supervised workers, the service is degraded if a worker has failed.
 func (t txController) Healthcheck(ctx context.Context) (*balancesvc.HealthcheckResult, error) {
 	res := balancesvc.HealthcheckResult{
 		Status: "ok",
 	}
 
+	for _, health := range workers.Health() {
+		worker := &balancesvc.WorkerHealth{
+			Name:    health.Name,
+			Status:  health.Status,
+			Crashes: health.Crashes,
+		}
+		if health.LastError != nil {
+			lastError := health.LastError.Error()
+			worker.LastError = &lastError
+		}
+		if health.Status == workers.WorkerFailed {
+			res.Status = "degraded"
+		}
+		res.Workers = append(res.Workers, worker)
+	}
+
 	return &res, nil
 }
 
 func NewTxController(db *gorm.DB) txsvc.Service {
 	return txController{
-		repo: repositories.NewTransactionRepository(db),
+		repo:             repositories.NewTransactionRepository(db),
+		balanceProvider:  services.NewBalanceProvider(db),
+		balanceHistory:   services.NewBalanceHistory(db),
+		syncTimeout:      viper.GetDuration("sync.timeout"),
+		syncPollInterval: viper.GetDuration("sync.poll_interval"),
+	}
+}
+
+func toTransactionResult(tx *entities.Transaction, reversed []entities.Transaction) *balancesvc.Transaction {
+	res := &balancesvc.Transaction{
+		ID:            tx.ID.String(),
+		TransactionID: tx.ExternalID,
+		WalletID:      tx.WalletID.String(),
+		Status:        tx.Status,
+		Amount:        tx.Amount.Format(tx.Currency),
+		Currency:      string(tx.Currency),
+		Action:        tx.Action,
+		SourceType:    tx.SourceType,
+		CreatedAt:     tx.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:     tx.UpdatedAt.Format(time.RFC3339),
+		CancelReason:  tx.CancelReason,
+	}
+
+	if tx.ProcessedAt != nil {
+		processedAt := tx.ProcessedAt.Format(time.RFC3339Nano)
+		res.ProcessedAt = &processedAt
+	}
+	if tx.ReversedByID != nil {
+		reversedBy := tx.ReversedByID.String()
+		res.ReversedBy = &reversedBy
+	}
+	for _, reversedTx := range reversed {
+		res.Reverses = append(res.Reverses, reversedTx.ID.String())
 	}
+
+	return res
+}
+
+func toTransactionFilter(payload *balancesvc.ListPayload) (repositories.TransactionFilter, error) {
+	var filter repositories.TransactionFilter
+
+	if payload.WalletID != nil {
+		walletID, err := uuid.Parse(*payload.WalletID)
+		if err != nil {
+			return filter, balancesvc.MakeInvalidInput(err)
+		}
+		filter.WalletID = &walletID
+	}
+	if payload.Status != nil {
+		filter.Status = *payload.Status
+	}
+	if payload.Action != nil {
+		filter.Action = *payload.Action
+	}
+	if payload.SourceType != nil {
+		filter.SourceType = *payload.SourceType
+	}
+	if payload.From != nil {
+		from, err := time.Parse(time.RFC3339, *payload.From)
+		if err != nil {
+			return filter, balancesvc.MakeInvalidInput(err)
+		}
+		filter.From = &from
+	}
+	if payload.To != nil {
+		to, err := time.Parse(time.RFC3339, *payload.To)
+		if err != nil {
+			return filter, balancesvc.MakeInvalidInput(err)
+		}
+		filter.To = &to
+	}
+	if payload.Cursor != nil {
+		cursor, err := repositories.ParseCursor(*payload.Cursor)
+		if err != nil {
+			return filter, balancesvc.MakeInvalidCursor(err)
+		}
+		filter.After = cursor
+	}
+
+	return filter, nil
 }
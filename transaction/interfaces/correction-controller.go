@@ -0,0 +1,141 @@
+package interfaces
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"time"
+	correctionsvc "wallet/gen/correction"
+	"wallet/transaction/internal/domain/entities"
+	"wallet/transaction/internal/domain/repositories"
+	"wallet/transaction/internal/domain/services"
+)
+
+type correctionController struct {
+	db *gorm.DB
+}
+
+func (c correctionController) Create(ctx context.Context, payload *correctionsvc.CreatePayload) (*correctionsvc.ManualCorrection, error) {
+	ids := make([]uuid.UUID, len(payload.TransactionIds))
+	for i, id := range payload.TransactionIds {
+		transactionID, err := uuid.Parse(id)
+		if err != nil {
+			return nil, correctionsvc.MakeInvalidInput(err)
+		}
+		ids[i] = transactionID
+	}
+
+	var (
+		correction *entities.ManualCorrection
+		reversal   *services.Reversal
+	)
+	err := c.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var err error
+		correction, reversal, err = services.NewManualCorrector(tx).Execute(ids, payload.Reason, payload.RequestedBy)
+
+		return err
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrTransactionNotFound):
+			return nil, correctionsvc.MakeNotFound(err)
+		case errors.Is(err, services.ErrWalletMismatch):
+			return nil, correctionsvc.MakeWalletMismatch(err)
+		case errors.Is(err, services.ErrMixedCurrencies):
+			return nil, correctionsvc.MakeMixedCurrencies(err)
+		case errors.Is(err, services.ErrTransactionNotDone):
+			return nil, correctionsvc.MakeNotDone(err)
+		default:
+			return nil, storageError(err, correctionsvc.MakeUnavailable)
+		}
+	}
+
+	reversal.Record()
+
+	return toManualCorrectionResult(correction), nil
+}
+
+func (c correctionController) List(ctx context.Context, payload *correctionsvc.ListPayload) (*correctionsvc.CorrectionRunList, error) {
+	var filter repositories.CorrectionRunFilter
+	if payload.WalletID != nil {
+		walletID, err := uuid.Parse(*payload.WalletID)
+		if err != nil {
+			return nil, correctionsvc.MakeInvalidInput(err)
+		}
+		filter.WalletID = &walletID
+	}
+	if payload.Cursor != nil {
+		cursor, err := repositories.ParseCursor(*payload.Cursor)
+		if err != nil {
+			return nil, correctionsvc.MakeInvalidCursor(err)
+		}
+		filter.After = cursor
+	}
+
+	// one more run is requested to find out if there is a next page
+	filter.Limit = payload.Limit + 1
+	runs, err := repositories.NewCorrectionRunRepository(c.db.WithContext(ctx)).List(filter)
+	if err != nil {
+		return nil, storageError(err, correctionsvc.MakeUnavailable)
+	}
+
+	res := correctionsvc.CorrectionRunList{
+		Runs: make([]*correctionsvc.CorrectionRun, 0, len(runs)),
+	}
+	if len(runs) > payload.Limit {
+		runs = runs[:payload.Limit]
+		last := runs[len(runs)-1]
+		cursor := repositories.Cursor{CreatedAt: last.CreatedAt, ID: last.ID}.String()
+		res.NextCursor = &cursor
+	}
+
+	for _, run := range runs {
+		res.Runs = append(res.Runs, toCorrectionRunResult(&run))
+	}
+
+	return &res, nil
+}
+
+func NewCorrectionController(db *gorm.DB) correctionsvc.Service {
+	return correctionController{db: db}
+}
+
+func toManualCorrectionResult(correction *entities.ManualCorrection) *correctionsvc.ManualCorrection {
+	res := &correctionsvc.ManualCorrection{
+		ID:             correction.ID.String(),
+		WalletID:       correction.WalletID.String(),
+		TransactionIds: correction.TransactionIDs,
+		Reason:         correction.Reason,
+		RequestedBy:    correction.RequestedBy,
+		CreatedAt:      correction.CreatedAt.Format(time.RFC3339),
+	}
+	if correction.CompensationID != nil {
+		compensationID := correction.CompensationID.String()
+		res.CompensationID = &compensationID
+	}
+
+	return res
+}
+
+func toCorrectionRunResult(run *entities.CorrectionRun) *correctionsvc.CorrectionRun {
+	res := &correctionsvc.CorrectionRun{
+		ID:             run.ID.String(),
+		Kind:           run.Kind,
+		WalletID:       run.WalletID.String(),
+		TransactionIds: run.TransactionIDs,
+		Delta:          run.Delta.Format(run.Currency),
+		Currency:       string(run.Currency),
+		StartedAt:      run.CreatedAt.Format(time.RFC3339),
+	}
+	if run.CompensationID != nil {
+		compensationID := run.CompensationID.String()
+		res.CompensationID = &compensationID
+	}
+	if run.FinishedAt != nil {
+		finishedAt := run.FinishedAt.Format(time.RFC3339)
+		res.FinishedAt = &finishedAt
+	}
+
+	return res
+}
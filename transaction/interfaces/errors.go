@@ -0,0 +1,16 @@
+package interfaces
+
+import (
+	goa "goa.design/goa/v3/pkg"
+	"wallet/transaction/internal/infrastructure/db"
+)
+
+// storageError reports the error as the unavailable error made by makeUnavailable if the database is down, any other
+// storage error is a bug and is returned as is, so it is reported as an internal error.
+func storageError(err error, makeUnavailable func(error) *goa.ServiceError) error {
+	if db.IsUnavailable(err) {
+		return makeUnavailable(err)
+	}
+
+	return err
+}
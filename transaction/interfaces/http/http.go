@@ -2,19 +2,26 @@ package http
 
 import (
 	"context"
+	"expvar"
 	"net/http"
 	"net/url"
 	"sync"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 	"goa.design/clue/debug"
 	"goa.design/clue/log"
 	goahttp "goa.design/goa/v3/http"
+	httpmdlwr "goa.design/goa/v3/http/middleware"
+	"goa.design/goa/v3/middleware"
+	"wallet/gen/correction"
+	correctionsrv "wallet/gen/http/correction/server"
 	txsrv "wallet/gen/http/transaction/server"
 	"wallet/gen/transaction"
 )
 
-func HandleHTTPServer(ctx context.Context, u *url.URL, endpoints *transaction.Endpoints, wg *sync.WaitGroup, errc chan error, dbg bool) {
+func HandleHTTPServer(ctx context.Context, u *url.URL, endpoints *transaction.Endpoints, correctionEndpoints *correction.Endpoints, wg *sync.WaitGroup, errc chan error, dbg bool) {
 	var (
 		dec = goahttp.RequestDecoder
 		enc = goahttp.ResponseEncoder
@@ -30,20 +37,30 @@ func HandleHTTPServer(ctx context.Context, u *url.URL, endpoints *transaction.En
 	}
 
 	var (
-		txServer *txsrv.Server
+		txServer         *txsrv.Server
+		correctionServer *correctionsrv.Server
 	)
 	{
 		eh := errorHandler(ctx)
-		txServer = txsrv.New(endpoints, mux, dec, enc, eh, nil)
+		txServer = txsrv.New(endpoints, mux, dec, enc, eh, errorFormatter)
+		correctionServer = correctionsrv.New(correctionEndpoints, mux, dec, enc, eh, errorFormatter)
 	}
 
 	txsrv.Mount(mux, txServer)
+	correctionsrv.Mount(mux, correctionServer)
+	mux.Handle("GET", "/debug/vars", expvar.Handler().ServeHTTP)
+	mux.Handle("GET", "/metrics", promhttp.Handler().ServeHTTP)
 	var handler http.Handler = mux
 	if dbg {
 		// Log query and response bodies if debug logs are enabled.
 		handler = debug.HTTP()(handler)
 	}
 	handler = log.HTTP(ctx)(handler)
+	handler = httpmdlwr.RequestID(httpmdlwr.UseXRequestIDHeaderOption(true))(handler)
+	// Start a trace for every request, continuing the trace of the caller if the traceparent header is sent.
+	handler = otelhttp.NewHandler(handler, "wallet", otelhttp.WithSpanNameFormatter(func(_ string, r *http.Request) string {
+		return r.Method + " " + r.URL.Path
+	}))
 
 	// Start HTTP server using default configuration, change the code to
 	// configure the server as required by your service.
@@ -51,6 +68,9 @@ func HandleHTTPServer(ctx context.Context, u *url.URL, endpoints *transaction.En
 	for _, m := range txServer.Mounts {
 		log.Printf(ctx, "HTTP %q mounted on %s %s", m.Method, m.Verb, m.Pattern)
 	}
+	for _, m := range correctionServer.Mounts {
+		log.Printf(ctx, "HTTP %q mounted on %s %s", m.Method, m.Verb, m.Pattern)
+	}
 
 	(*wg).Add(1)
 	go func() {
@@ -81,6 +101,26 @@ func HandleHTTPServer(ctx context.Context, u *url.URL, endpoints *transaction.En
 // to correlate.
 func errorHandler(logCtx context.Context) func(context.Context, http.ResponseWriter, error) {
 	return func(ctx context.Context, w http.ResponseWriter, err error) {
-		log.Printf(logCtx, "ERROR: %s", err.Error())
+		log.Printf(logCtx, "ERROR [%s]: %s", requestID(ctx), err.Error())
 	}
 }
+
+// errorFormatter formats errors as goa error responses, the error name is the error code and the error ID is
+// the request ID, so that a client can report it and it can be found in the logs.
+func errorFormatter(ctx context.Context, err error) goahttp.Statuser {
+	resp := goahttp.NewErrorResponse(ctx, err)
+	if errResp, ok := resp.(*goahttp.ErrorResponse); ok {
+		if id := requestID(ctx); id != "" {
+			errResp.ID = id
+		}
+		log.Printf(ctx, "ERROR [%s] %s: %s", errResp.ID, errResp.Name, errResp.Message)
+	}
+
+	return resp
+}
+
+// requestID returns the request ID stored in the context by the request ID middleware.
+func requestID(ctx context.Context) string {
+	id, _ := ctx.Value(middleware.RequestIDKey).(string)
+	return id
+}
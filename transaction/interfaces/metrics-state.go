@@ -0,0 +1,43 @@
+package interfaces
+
+import (
+	"gorm.io/gorm"
+	"math"
+	"wallet/transaction/internal/domain/entities"
+	"wallet/transaction/internal/domain/repositories"
+	"wallet/transaction/internal/infrastructure/metrics"
+)
+
+// metricsState reads the state of the database reported by the metrics from the repositories.
+type metricsState struct {
+	balanceRepo *repositories.BalanceRepository
+	txRepo      *repositories.TransactionRepository
+}
+
+// BalanceSums returns the sum of the current wallet balances by currency in major units.
+func (m metricsState) BalanceSums() (map[string]float64, error) {
+	sums, err := m.balanceRepo.SumByCurrency()
+	if err != nil {
+		return nil, err
+	}
+
+	values := make(map[string]float64, len(sums))
+	for currency, sum := range sums {
+		values[string(currency)] = float64(sum) / math.Pow10(currency.MinorUnits())
+	}
+
+	return values, nil
+}
+
+// QueuedTransactions returns the number of new and locked transactions by status.
+func (m metricsState) QueuedTransactions() (map[string]int64, error) {
+	return m.txRepo.CountByStatus([]string{entities.New, entities.Locked})
+}
+
+// NewMetricsState returns the state reported by the metrics read from the given database.
+func NewMetricsState(db *gorm.DB) metrics.State {
+	return metricsState{
+		balanceRepo: repositories.NewBalanceRepository(db),
+		txRepo:      repositories.NewTransactionRepository(db),
+	}
+}
@@ -0,0 +1,36 @@
+package entities
+
+import (
+	"github.com/google/uuid"
+	"time"
+	"wallet/transaction/internal/domain/vo"
+)
+
+// BalanceDiscrepancy represents the BalanceDiscrepancy entity, which records a stored balance found to differ from
+// the sum of the done transactions of the wallet during a reconciliation.
+type BalanceDiscrepancy struct {
+	ID         uuid.UUID      `gorm:"type:uuid;primaryKey"`
+	WalletID   uuid.UUID      `gorm:"type:uuid;not null;index"`
+	Stored     vo.TotalAmount `gorm:"type:bigint;not null"`
+	Calculated vo.TotalAmount `gorm:"type:bigint;not null"`
+	Currency   vo.Currency    `gorm:"type:varchar(3);not null;default:'EUR'"`
+	Repaired   bool           `gorm:"not null;default:false"`
+	DetectedAt time.Time      `gorm:"type:timestamptz;default:current_timestamp;index"`
+}
+
+// Drift returns the difference between the stored and the calculated balance.
+func (d BalanceDiscrepancy) Drift() int64 {
+	return d.Stored.Cents - d.Calculated.Cents
+}
+
+// NewBalanceDiscrepancy returns new BalanceDiscrepancy entity instance detected now.
+func NewBalanceDiscrepancy(balance *Balance, calculated vo.TotalAmount) *BalanceDiscrepancy {
+	return &BalanceDiscrepancy{
+		ID:         uuid.New(),
+		WalletID:   balance.WalletID,
+		Stored:     balance.Value,
+		Calculated: calculated,
+		Currency:   balance.Currency,
+		DetectedAt: time.Now(),
+	}
+}
@@ -0,0 +1,30 @@
+package entities
+
+import (
+	"github.com/google/uuid"
+	"time"
+	"wallet/transaction/internal/domain/vo"
+)
+
+// BalanceSnapshot represents the BalanceSnapshot entity, the balance of the wallet at the TakenAt time. Point in time
+// balances are calculated from the latest snapshot and the transactions processed after it.
+type BalanceSnapshot struct {
+	ID        uuid.UUID      `gorm:"type:uuid;primaryKey"`
+	WalletID  uuid.UUID      `gorm:"type:uuid;not null;uniqueIndex:idx_balance_snapshots_wallet_taken_at,priority:1"`
+	Value     vo.TotalAmount `gorm:"type:bigint;not null"`
+	Currency  vo.Currency    `gorm:"type:varchar(3);not null;default:'EUR'"`
+	TakenAt   time.Time      `gorm:"type:timestamptz;not null;uniqueIndex:idx_balance_snapshots_wallet_taken_at,priority:2"`
+	CreatedAt time.Time      `gorm:"type:timestamptz;default:current_timestamp"`
+}
+
+// NewBalanceSnapshot returns new BalanceSnapshot entity instance of the given balance at the given time.
+func NewBalanceSnapshot(balance *Balance, takenAt time.Time) *BalanceSnapshot {
+	return &BalanceSnapshot{
+		ID:        uuid.New(),
+		WalletID:  balance.WalletID,
+		Value:     balance.Value,
+		Currency:  balance.Currency,
+		TakenAt:   takenAt,
+		CreatedAt: time.Now(),
+	}
+}
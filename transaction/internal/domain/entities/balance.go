@@ -5,19 +5,22 @@ import (
 	"wallet/transaction/internal/domain/vo"
 )
 
-// BalanceID the balance entry id.
-const BalanceID = "0f31adad-bfb6-41d1-aeff-c110ca13cbfa" //Hardcoded %(
-
-// Balance represents the Balance entity, responsible for storing the current state of the balance.
+// Balance represents the Balance entity, responsible for storing the current state of the wallet balance.
+// The Version is incremented by every update, so concurrent modifications of the balance can be detected.
 type Balance struct {
-	ID    uuid.UUID      `gorm:"type:uuid;primaryKey"`
-	Value vo.TotalAmount `gorm:"type:bigint;not null"`
+	ID       uuid.UUID      `gorm:"type:uuid;primaryKey"`
+	WalletID uuid.UUID      `gorm:"type:uuid;not null;uniqueIndex"`
+	Value    vo.TotalAmount `gorm:"type:bigint;not null"`
+	Currency vo.Currency    `gorm:"type:varchar(3);not null;default:'EUR'"`
+	Version  int64          `gorm:"not null;default:0"`
 }
 
-// NewBalance returns new Balance entity instance.
-func NewBalance(value vo.TotalAmount) *Balance {
+// NewBalance returns new Balance entity instance for the given wallet.
+func NewBalance(walletID uuid.UUID, value vo.TotalAmount, currency vo.Currency) *Balance {
 	return &Balance{
-		ID:    uuid.MustParse(BalanceID),
-		Value: value,
+		ID:       uuid.New(),
+		WalletID: walletID,
+		Value:    value,
+		Currency: currency,
 	}
 }
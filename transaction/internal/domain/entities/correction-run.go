@@ -0,0 +1,45 @@
+package entities
+
+import (
+	"github.com/google/uuid"
+	"time"
+	"wallet/transaction/internal/domain/vo"
+)
+
+// Automatic correction run kind, the run is executed by the correction worker.
+const Automatic = "automatic"
+
+// Manual correction run kind, the run is requested by a support agent.
+const Manual = "manual"
+
+// CorrectionRun represents the CorrectionRun entity, which records a single execution of a correction: the cancelled
+// transactions, the compensating transaction and the delta it applied to the wallet balance.
+type CorrectionRun struct {
+	ID             uuid.UUID         `gorm:"type:uuid;primaryKey"`
+	Kind           string            `gorm:"type:varchar(10);not null"`
+	WalletID       uuid.UUID         `gorm:"type:uuid;not null;index"`
+	TransactionIDs vo.TransactionIds `gorm:"type:jsonb;not null"`
+	CompensationID *uuid.UUID        `gorm:"type:uuid;default:null"`
+	Delta          vo.Amount         `gorm:"type:integer;not null"`
+	Currency       vo.Currency       `gorm:"type:varchar(3);not null;default:'EUR'"`
+	CreatedAt      time.Time         `gorm:"type:timestamptz;default:current_timestamp;index"`
+	FinishedAt     *time.Time        `gorm:"type:timestamptz;default:null"`
+}
+
+// Finish marks the run as finished.
+func (r *CorrectionRun) Finish() {
+	now := time.Now()
+	r.FinishedAt = &now
+}
+
+// NewCorrectionRun returns new CorrectionRun entity instance started now.
+func NewCorrectionRun(kind string, walletID uuid.UUID) *CorrectionRun {
+	return &CorrectionRun{
+		ID:             uuid.New(),
+		Kind:           kind,
+		WalletID:       walletID,
+		TransactionIDs: vo.TransactionIds{},
+		Currency:       vo.DefaultCurrency,
+		CreatedAt:      time.Now(),
+	}
+}
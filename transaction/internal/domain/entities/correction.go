@@ -7,14 +7,13 @@ import (
 
 const Ready = "ready"
 
-// CorrectionId correction id
-const CorrectionId = "3d8e7990-7a74-4613-9ed4-154dbba1d3b5"
-
-// Correction represents the Correction entity, which is a scheduled operation for reversing a list of transactions.
+// Correction represents the Correction entity, which is a scheduled operation for reversing a list of transactions
+// of a single wallet.
 type Correction struct {
 	ID        uuid.UUID `gorm:"type:uuid;primaryKey"`
+	WalletID  uuid.UUID `gorm:"type:uuid;not null;uniqueIndex"`
 	DoneAt    *time.Time
-	Status    string     `gorm:"type:varchar(10);check:status IN ('ready', 'locked');index"`
+	Status    string     `gorm:"type:varchar(10);index"`
 	LockUuid  *uuid.UUID `gorm:"type:uuid;default:null"`
 	LockedAt  *time.Time `gorm:"type:timestamptz;default:null"`
 	CreatedAt time.Time  `gorm:"type:timestamptz;default:current_timestamp"`
@@ -34,25 +33,23 @@ func (c *Correction) Lock(lockUuid uuid.UUID) {
 	*c.LockedAt = time.Now()
 }
 
-// IsOutOFDate returns true if correction is too old
-func (c *Correction) IsOutOFDate() bool {
+// IsOutOFDate returns true if correction was done more than the interval ago
+func (c *Correction) IsOutOFDate(interval time.Duration) bool {
 	if c.DoneAt == nil {
 		return false
 	}
 
-	doneUnix := c.DoneAt.Unix()
-	nowUnix := time.Now().Unix()
-
-	return nowUnix-doneUnix > 600
+	return time.Since(*c.DoneAt) > interval
 }
 
-// NewCorrection returns new Correction entity instance.
-func NewCorrection(id uuid.UUID) *Correction {
+// NewCorrection returns new Correction entity instance for the given wallet.
+func NewCorrection(walletID uuid.UUID) *Correction {
 	now := time.Now()
 	lockUuid := uuid.New()
 	return &Correction{
-		ID:     id,
-		Status: Ready,
+		ID:       uuid.New(),
+		WalletID: walletID,
+		Status:   Ready,
 		// avoid correction on service starting:
 		LockedAt: &now,
 		DoneAt:   &now,
@@ -0,0 +1,31 @@
+package entities
+
+import (
+	"github.com/google/uuid"
+	"time"
+	"wallet/transaction/internal/domain/vo"
+)
+
+// ManualCorrection represents the ManualCorrection entity, which records the transactions cancelled on request
+// of a support agent and the compensating transaction posted instead of them.
+type ManualCorrection struct {
+	ID             uuid.UUID         `gorm:"type:uuid;primaryKey"`
+	WalletID       uuid.UUID         `gorm:"type:uuid;not null;index"`
+	TransactionIDs vo.TransactionIds `gorm:"type:jsonb;not null"`
+	CompensationID *uuid.UUID        `gorm:"type:uuid;default:null"`
+	Reason         string            `gorm:"type:text;not null"`
+	RequestedBy    string            `gorm:"type:varchar(128);not null"`
+	CreatedAt      time.Time         `gorm:"type:timestamptz;default:current_timestamp"`
+}
+
+// NewManualCorrection returns new ManualCorrection entity instance.
+func NewManualCorrection(walletID uuid.UUID, transactionIDs vo.TransactionIds, reason string, requestedBy string) *ManualCorrection {
+	return &ManualCorrection{
+		ID:             uuid.New(),
+		WalletID:       walletID,
+		TransactionIDs: transactionIDs,
+		Reason:         reason,
+		RequestedBy:    requestedBy,
+		CreatedAt:      time.Now(),
+	}
+}
@@ -0,0 +1,73 @@
+package entities
+
+import (
+	"github.com/google/uuid"
+	"time"
+	"wallet/transaction/internal/domain/vo"
+)
+
+// Debit posting direction, decreases the wallet account.
+const Debit = "debit"
+
+// Credit posting direction, increases the wallet account.
+const Credit = "credit"
+
+// WalletAccount is the account holding the wallet balance, the counter account of a posting is named after the
+// source type of the transaction.
+const WalletAccount = "wallet"
+
+// Posting represents the Posting entity, a single append-only ledger entry. Every processed transaction produces
+// a balanced pair of postings: one on the wallet account and one on the account of the transaction source.
+type Posting struct {
+	ID            uuid.UUID      `gorm:"type:uuid;primaryKey"`
+	TransactionID uuid.UUID      `gorm:"type:uuid;not null;index"`
+	WalletID      uuid.UUID      `gorm:"type:uuid;not null;index:idx_postings_wallet_account,priority:1"`
+	Account       string         `gorm:"type:varchar(10);not null;index:idx_postings_wallet_account,priority:2"`
+	Direction     string         `gorm:"type:varchar(6);not null"`
+	Amount        vo.TotalAmount `gorm:"type:bigint;not null"`
+	Currency      vo.Currency    `gorm:"type:varchar(3);not null;default:'EUR'"`
+	CreatedAt     time.Time      `gorm:"type:timestamptz;default:current_timestamp;index"`
+}
+
+// Signed returns the posting amount as it affects the account balance: credits are positive, debits are negative.
+func (p Posting) Signed() int64 {
+	if p.Direction == Debit {
+		return -p.Amount.Cents
+	}
+
+	return p.Amount.Cents
+}
+
+// NewPostings returns the balanced pair of postings for the given transaction, a win credits the wallet account
+// and debits the source account, a loss does the opposite. A transaction with zero amount produces no postings.
+func NewPostings(transaction *Transaction) []Posting {
+	if transaction.Amount.IsZero() {
+		return nil
+	}
+
+	walletDirection, sourceDirection := Credit, Debit
+	cents := int64(transaction.Amount.Cents)
+	if cents < 0 {
+		walletDirection, sourceDirection = Debit, Credit
+		cents = -cents
+	}
+
+	now := time.Now()
+	newPosting := func(account string, direction string) Posting {
+		return Posting{
+			ID:            uuid.New(),
+			TransactionID: transaction.ID,
+			WalletID:      transaction.WalletID,
+			Account:       account,
+			Direction:     direction,
+			Amount:        vo.NewTotalAmount(cents),
+			Currency:      transaction.Currency,
+			CreatedAt:     now,
+		}
+	}
+
+	return []Posting{
+		newPosting(WalletAccount, walletDirection),
+		newPosting(transaction.SourceType, sourceDirection),
+	}
+}
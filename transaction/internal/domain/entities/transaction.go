@@ -38,17 +38,39 @@ const Cancelled = "cancelled"
 // Locked status.
 const Locked = "locked"
 
+// InsufficientFunds cancellation reason, the transaction would make the balance negative.
+const InsufficientFunds = "insufficient_funds"
+
+// CurrencyMismatch cancellation reason, the transaction currency differs from the wallet currency.
+const CurrencyMismatch = "currency_mismatch"
+
+// Reversed cancellation reason, the transaction was cancelled by a correction.
+const Reversed = "reversed"
+
 // Transaction represents the Transaction entity, which stores all incoming requests for changing the user's balance.
+// The ExternalID is the ID given by the source, it is unique only within the source type. The ReversedByID is the ID
+// of the compensating transaction added by the correction which cancelled the transaction. The ProcessedAt is the time
+// the transaction was done or cancelled by the balance worker, the ReversedAt is the time it was cancelled by a correction.
+// The CancelReason is the reason the transaction was cancelled for.
+// The TraceParent is the W3C trace context of the request which created the transaction.
 type Transaction struct {
-	ID         string     `gorm:"type:varchar(128);primaryKey"`
-	Status     string     `gorm:"type:varchar(10);check:status IN ('new','done','cancelled', 'locked');index"`
-	SourceType string     `gorm:"type:varchar(10);check:source_type IN ('game','server','payment', 'internal')"`
-	Action     string     `gorm:"type:varchar(10);check:action IN ('win','lost')"`
-	Amount     vo.Amount  `gorm:"type:integer"`
-	LockUuid   *uuid.UUID `gorm:"type:uuid;default:null"`
-	LockedAt   *time.Time `gorm:"type:timestamptz;default:null"`
-	CreatedAt  time.Time  `gorm:"type:timestamptz;default:current_timestamp;index"`
-	UpdatedAt  time.Time  `gorm:"type:timestamptz;default:current_timestamp"`
+	ID           uuid.UUID   `gorm:"type:uuid;primaryKey"`
+	ExternalID   string      `gorm:"type:varchar(128);not null;uniqueIndex:idx_transactions_source_external_id,priority:2"`
+	WalletID     uuid.UUID   `gorm:"type:uuid;not null;index"`
+	Status       string      `gorm:"type:varchar(10);index"`
+	SourceType   string      `gorm:"type:varchar(10);uniqueIndex:idx_transactions_source_external_id,priority:1"`
+	Action       string      `gorm:"type:varchar(10)"`
+	Amount       vo.Amount   `gorm:"type:integer"`
+	Currency     vo.Currency `gorm:"type:varchar(3);not null;default:'EUR'"`
+	ReversedByID *uuid.UUID  `gorm:"type:uuid;default:null;index"`
+	ProcessedAt  *time.Time  `gorm:"type:timestamptz;default:null;index"`
+	ReversedAt   *time.Time  `gorm:"type:timestamptz;default:null"`
+	CancelReason *string     `gorm:"type:varchar(20);default:null"`
+	LockUuid     *uuid.UUID  `gorm:"type:uuid;default:null"`
+	LockedAt     *time.Time  `gorm:"type:timestamptz;default:null"`
+	TraceParent  *string     `gorm:"column:traceparent;type:varchar(55);default:null"`
+	CreatedAt    time.Time   `gorm:"type:timestamptz;default:current_timestamp;index"`
+	UpdatedAt    time.Time   `gorm:"type:timestamptz;default:current_timestamp"`
 }
 
 func (t Transaction) ToJSON() (string, error) {
@@ -62,16 +84,53 @@ func (t Transaction) ToJSON() (string, error) {
 // MarkAsDone mark Transaction as done.
 func (t *Transaction) MarkAsDone() {
 	t.Status = Done
+	t.markAsProcessed()
 	//t.LockUuid = nil
 }
 
-// MarkAsCancelled mark Transaction as cancelled
-func (t *Transaction) MarkAsCancelled() {
+// MarkAsCancelled mark Transaction as cancelled for the given reason.
+func (t *Transaction) MarkAsCancelled(reason string) {
 	t.Status = Cancelled
+	t.CancelReason = &reason
+	t.markAsProcessed()
+}
+
+// MarkAsReversed mark Transaction as cancelled by a correction and link it to the compensating transaction,
+// the compensating transaction is nil if the cancelled amounts sum up to zero.
+func (t *Transaction) MarkAsReversed(compensation *Transaction) {
+	t.MarkAsCancelled(Reversed)
+	now := time.Now()
+	t.ReversedAt = &now
+	if compensation != nil {
+		t.ReversedByID = &compensation.ID
+	}
+}
+
+// markAsProcessed sets the processing time, it is kept when an already processed transaction is reversed.
+func (t *Transaction) markAsProcessed() {
+	if t.ProcessedAt == nil {
+		now := time.Now()
+		t.ProcessedAt = &now
+	}
+}
+
+// IsProcessed returns true if the transaction was already handled by the balance worker.
+func (t *Transaction) IsProcessed() bool {
+	return t.Status == Done || t.Status == Cancelled
+}
+
+// SamePayload returns true if the other transaction was created from the same request payload.
+func (t *Transaction) SamePayload(other *Transaction) bool {
+	return t.WalletID == other.WalletID &&
+		t.Amount.Equal(other.Amount) &&
+		t.Currency == other.Currency &&
+		t.Action == other.Action &&
+		t.SourceType == other.SourceType
 }
 
+// IsInternal returns true if the transaction was added by the service itself, e.g. by a correction.
 func (t *Transaction) IsInternal() bool {
-	return t.Status == Internal
+	return t.SourceType == Internal
 }
 
 // Lock book transaction by worker process
@@ -81,13 +140,16 @@ func (t *Transaction) Lock(lockUuid uuid.UUID) {
 	*t.LockedAt = time.Now()
 }
 
-// NewTransaction returns new Transaction entity.
-func NewTransaction(id string, amount vo.Amount, action string, sourceType string) *Transaction {
+// NewTransaction returns new Transaction entity with a random ID.
+func NewTransaction(externalID string, walletID uuid.UUID, amount vo.Amount, currency vo.Currency, action string, sourceType string) *Transaction {
 	return &Transaction{
+		ID:         uuid.New(),
+		ExternalID: externalID,
+		WalletID:   walletID,
+		Currency:   currency,
 		Status:     New,
 		Action:     action,
 		SourceType: sourceType,
 		Amount:     amount,
-		ID:         id,
 	}
 }
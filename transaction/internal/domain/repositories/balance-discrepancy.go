@@ -0,0 +1,34 @@
+package repositories
+
+import (
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"wallet/transaction/internal/domain/entities"
+)
+
+// BalanceDiscrepancyRepository balance discrepancy repository.
+type BalanceDiscrepancyRepository struct {
+	db *gorm.DB
+}
+
+// Save saves the balance discrepancy entity to the database.
+func (repo BalanceDiscrepancyRepository) Save(discrepancy *entities.BalanceDiscrepancy) error {
+	return repo.db.Save(discrepancy).Error
+}
+
+// FindByWalletID returns the discrepancies of the wallet, the most recent first.
+func (repo BalanceDiscrepancyRepository) FindByWalletID(walletID uuid.UUID) ([]entities.BalanceDiscrepancy, error) {
+	var discrepancies []entities.BalanceDiscrepancy
+
+	result := repo.db.Where("wallet_id = ?", walletID).Order("detected_at DESC").Find(&discrepancies)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return discrepancies, nil
+}
+
+// NewBalanceDiscrepancyRepository returns BalanceDiscrepancyRepository instance.
+func NewBalanceDiscrepancyRepository(db *gorm.DB) *BalanceDiscrepancyRepository {
+	return &BalanceDiscrepancyRepository{db: db}
+}
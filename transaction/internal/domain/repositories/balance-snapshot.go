@@ -0,0 +1,61 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"time"
+	"wallet/transaction/internal/domain/entities"
+)
+
+// BalanceSnapshotRepository balance snapshot repository.
+type BalanceSnapshotRepository struct {
+	db *gorm.DB
+}
+
+// Create saves the snapshot to the database, doing nothing if the wallet already has a snapshot taken at the same time.
+func (repo BalanceSnapshotRepository) Create(snapshot *entities.BalanceSnapshot) error {
+	return repo.db.Clauses(clause.OnConflict{DoNothing: true}).Create(snapshot).Error
+}
+
+// FindLatest returns the latest snapshot of the wallet taken at or before the given time, returns nil if there is none.
+func (repo BalanceSnapshotRepository) FindLatest(walletID uuid.UUID, at time.Time) (*entities.BalanceSnapshot, error) {
+	var snapshot entities.BalanceSnapshot
+
+	err := repo.db.Where("wallet_id = ? AND taken_at <= ?", walletID, at).
+		Order("taken_at DESC").
+		Limit(1).
+		First(&snapshot).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &snapshot, nil
+}
+
+// LatestTakenAt returns the time of the latest snapshot of any wallet, returns nil if there are no snapshots yet.
+func (repo BalanceSnapshotRepository) LatestTakenAt() (*time.Time, error) {
+	var takenAt *time.Time
+
+	result := repo.db.Model(&entities.BalanceSnapshot{}).Select("MAX(taken_at)").Scan(&takenAt)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return takenAt, nil
+}
+
+// WithContext returns a copy of the repository running its queries with the given context.
+func (repo BalanceSnapshotRepository) WithContext(ctx context.Context) *BalanceSnapshotRepository {
+	return &BalanceSnapshotRepository{db: repo.db.WithContext(ctx)}
+}
+
+// NewBalanceSnapshotRepository returns BalanceSnapshotRepository instance.
+func NewBalanceSnapshotRepository(db *gorm.DB) *BalanceSnapshotRepository {
+	return &BalanceSnapshotRepository{db: db}
+}
@@ -1,10 +1,14 @@
 package repositories
 
 import (
+	"context"
 	"errors"
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 	"wallet/transaction/internal/domain/entities"
+	"wallet/transaction/internal/domain/vo"
 )
 
 // BalanceRepository Balance repository
@@ -12,17 +16,58 @@ type BalanceRepository struct {
 	db *gorm.DB
 }
 
-// Save saves the balance entity to the database and returns any encountered error.
+// ErrConcurrentModification is returned when the balance was modified or created by another process since it was read.
+var ErrConcurrentModification = errors.New("balance was modified concurrently")
+
+// Save saves the balance entity to the database if it was not modified since it was read and increments its version,
+// returns ErrConcurrentModification otherwise. A balance which was never saved is created.
 func (repo BalanceRepository) Save(balance *entities.Balance) error {
-	return repo.db.Save(balance).Error
+	result := repo.db.Model(&entities.Balance{}).
+		Where("id = ? AND version = ?", balance.ID, balance.Version).
+		Updates(map[string]interface{}{
+			"value":    balance.Value,
+			"currency": balance.Currency,
+			"version":  balance.Version + 1,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		if balance.Version > 0 {
+			return ErrConcurrentModification
+		}
+
+		return repo.create(balance)
+	}
+
+	balance.Version++
+
+	return nil
 }
 
-// Get retrieves a balance entity from the database by its ID.
-func (repo BalanceRepository) Get() (*entities.Balance, error) {
+// create inserts the balance within a savepoint, so a concurrently created balance of the same wallet does not
+// abort the surrounding database transaction.
+func (repo BalanceRepository) create(balance *entities.Balance) error {
+	err := repo.db.Transaction(func(tx *gorm.DB) error {
+		return tx.Create(balance).Error
+	})
+	if err != nil {
+		if pgErr, ok := err.(*pgconn.PgError); ok && pgErr.Code == "23505" {
+			return ErrConcurrentModification
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// Get retrieves a balance entity of the given wallet from the database.
+func (repo BalanceRepository) Get(walletID uuid.UUID) (*entities.Balance, error) {
 	var balance entities.Balance
-	balanceID := uuid.MustParse(entities.BalanceID)
 
-	if err := repo.db.Where("id = ?", balanceID).Limit(1).First(&balance).Error; err != nil {
+	if err := repo.db.Where("wallet_id = ?", walletID).Limit(1).First(&balance).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, nil
 		}
@@ -32,6 +77,66 @@ func (repo BalanceRepository) Get() (*entities.Balance, error) {
 	return &balance, nil
 }
 
+// GetForUpdate retrieves a balance entity of the given wallet and locks it until the end of the database transaction,
+// returns nil if the wallet has no balance.
+func (repo BalanceRepository) GetForUpdate(walletID uuid.UUID) (*entities.Balance, error) {
+	var balance entities.Balance
+
+	err := repo.db.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("wallet_id = ?", walletID).
+		Limit(1).
+		First(&balance).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &balance, nil
+}
+
+// FindWalletIDs returns IDs of all wallets which have a balance.
+func (repo BalanceRepository) FindWalletIDs() ([]uuid.UUID, error) {
+	var walletIDs []uuid.UUID
+
+	result := repo.db.Model(&entities.Balance{}).Order("wallet_id ASC").Pluck("wallet_id", &walletIDs)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return walletIDs, nil
+}
+
+// SumByCurrency returns the sum of the balances of all wallets in cents per currency.
+func (repo BalanceRepository) SumByCurrency() (map[vo.Currency]int64, error) {
+	var rows []struct {
+		Currency vo.Currency
+		Total    int64
+	}
+
+	result := repo.db.Model(&entities.Balance{}).
+		Select("currency, sum(value) AS total").
+		Group("currency").
+		Scan(&rows)
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	sums := make(map[vo.Currency]int64, len(rows))
+	for _, row := range rows {
+		sums[row.Currency] = row.Total
+	}
+
+	return sums, nil
+}
+
+// WithContext returns a copy of the repository running its queries with the given context.
+func (repo BalanceRepository) WithContext(ctx context.Context) *BalanceRepository {
+	return &BalanceRepository{db: repo.db.WithContext(ctx)}
+}
+
 // NewBalanceRepository returns BalanceRepository instance.
 func NewBalanceRepository(db *gorm.DB) *BalanceRepository {
 	return &BalanceRepository{db: db}
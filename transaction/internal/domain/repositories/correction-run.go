@@ -0,0 +1,64 @@
+package repositories
+
+import (
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"wallet/transaction/internal/domain/entities"
+)
+
+// CorrectionRunFilter describes which correction runs should be listed and the page to return.
+type CorrectionRunFilter struct {
+	WalletID *uuid.UUID
+	After    *Cursor
+	Limit    int
+}
+
+// CorrectionRunRepository correction run repository.
+type CorrectionRunRepository struct {
+	db *gorm.DB
+}
+
+// Save saves the correction run entity to the database.
+func (repo CorrectionRunRepository) Save(run *entities.CorrectionRun) error {
+	return repo.db.Save(run).Error
+}
+
+// List returns a page of correction runs matching the filter, ordered from the newest to the oldest.
+func (repo CorrectionRunRepository) List(filter CorrectionRunFilter) ([]entities.CorrectionRun, error) {
+	var runs []entities.CorrectionRun
+
+	query := repo.db.Model(&entities.CorrectionRun{})
+	if filter.WalletID != nil {
+		query = query.Where("wallet_id = ?", *filter.WalletID)
+	}
+	if filter.After != nil {
+		query = query.Where("(created_at, id) < (?, ?)", filter.After.CreatedAt, filter.After.ID)
+	}
+
+	result := query.Order("created_at DESC, id DESC").
+		Limit(filter.Limit).
+		Find(&runs)
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return runs, nil
+}
+
+// FindAll returns all correction runs
+func (repo CorrectionRunRepository) FindAll() ([]entities.CorrectionRun, error) {
+	var runs []entities.CorrectionRun
+
+	result := repo.db.Find(&runs)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return runs, nil
+}
+
+// NewCorrectionRunRepository returns CorrectionRunRepository instance.
+func NewCorrectionRunRepository(db *gorm.DB) *CorrectionRunRepository {
+	return &CorrectionRunRepository{db: db}
+}
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"github.com/google/uuid"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 	"time"
 	"wallet/transaction/internal/domain/entities"
 )
@@ -30,11 +31,18 @@ func (repo CorrectionRepository) FindByID(id uuid.UUID) (*entities.Correction, e
 	return &correction, nil
 }
 
-func (repo CorrectionRepository) Get() (*entities.Correction, error) {
+// Create creates the correction entity in the database, doing nothing if the wallet already has a correction.
+func (repo CorrectionRepository) Create(correction *entities.Correction) error {
+	correction.UpdatedAt = time.Now()
+
+	return repo.db.Clauses(clause.OnConflict{DoNothing: true}).Create(correction).Error
+}
+
+// Get retrieves the correction of the given wallet.
+func (repo CorrectionRepository) Get(walletID uuid.UUID) (*entities.Correction, error) {
 	var correction entities.Correction
-	correctionID := uuid.MustParse(entities.CorrectionId)
 
-	if err := repo.db.Where("id = ?", correctionID).Limit(1).First(&correction).Error; err != nil {
+	if err := repo.db.Where("wallet_id = ?", walletID).Limit(1).First(&correction).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, nil
 		}
@@ -57,10 +65,10 @@ func (repo CorrectionRepository) GetActualCorrection() (*entities.Correction, er
 	return &correction, nil
 }
 
-// Lock locks correction which is ready to execute or which is frozen
-func (repo CorrectionRepository) Lock(lockUuid uuid.UUID) error {
-	threshold1 := time.Now().Add(-10 * time.Minute)
-	thresholdForFrozen := time.Now().Add(-10 * time.Minute)
+// Lock locks corrections which were done more than the interval ago or which are frozen
+func (repo CorrectionRepository) Lock(lockUuid uuid.UUID, interval time.Duration) error {
+	threshold1 := time.Now().Add(-interval)
+	thresholdForFrozen := time.Now().Add(-interval)
 
 	result := repo.db.Model(&entities.Correction{}).
 		Where("(status = ? AND done_at < ?) OR (status = ? AND locked_at < ?)", "ready", threshold1, "locked", thresholdForFrozen).
@@ -77,7 +85,37 @@ func (repo CorrectionRepository) Lock(lockUuid uuid.UUID) error {
 	return nil
 }
 
-// FindAll returns all transactions
+// GetLockedCorrections returns all corrections locked by the given lock.
+func (repo CorrectionRepository) GetLockedCorrections(lockUuid uuid.UUID) ([]entities.Correction, error) {
+	var corrections []entities.Correction
+
+	result := repo.db.Where("status = ? AND lock_uuid = ?", entities.Locked, lockUuid).
+		Order("done_at ASC").
+		Find(&corrections)
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return corrections, nil
+}
+
+// FindUnscheduledWallets returns IDs of the wallets which have a balance but no correction yet.
+func (repo CorrectionRepository) FindUnscheduledWallets() ([]uuid.UUID, error) {
+	var walletIDs []uuid.UUID
+
+	result := repo.db.Model(&entities.Balance{}).
+		Where("wallet_id NOT IN (?)", repo.db.Model(&entities.Correction{}).Select("wallet_id")).
+		Pluck("wallet_id", &walletIDs)
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return walletIDs, nil
+}
+
+// FindAll returns all corrections
 func (repo CorrectionRepository) FindAll() ([]entities.Correction, error) {
 	var corrections []entities.Correction
 
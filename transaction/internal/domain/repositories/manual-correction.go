@@ -0,0 +1,33 @@
+package repositories
+
+import (
+	"gorm.io/gorm"
+	"wallet/transaction/internal/domain/entities"
+)
+
+// ManualCorrectionRepository manual correction repository.
+type ManualCorrectionRepository struct {
+	db *gorm.DB
+}
+
+// Save saves the manual correction entity to the database.
+func (repo ManualCorrectionRepository) Save(correction *entities.ManualCorrection) error {
+	return repo.db.Save(correction).Error
+}
+
+// FindAll returns all manual corrections
+func (repo ManualCorrectionRepository) FindAll() ([]entities.ManualCorrection, error) {
+	var corrections []entities.ManualCorrection
+
+	result := repo.db.Find(&corrections)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return corrections, nil
+}
+
+// NewManualCorrectionRepository returns ManualCorrectionRepository instance.
+func NewManualCorrectionRepository(db *gorm.DB) *ManualCorrectionRepository {
+	return &ManualCorrectionRepository{db: db}
+}
@@ -0,0 +1,70 @@
+package repositories
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"time"
+	"wallet/transaction/internal/domain/entities"
+)
+
+// PostingRepository ledger postings repository, postings are only ever appended.
+type PostingRepository struct {
+	db *gorm.DB
+}
+
+// Create appends the postings to the ledger.
+func (repo PostingRepository) Create(postings []entities.Posting) error {
+	if len(postings) == 0 {
+		return nil
+	}
+
+	return repo.db.Create(&postings).Error
+}
+
+// FindByTransactionID returns the postings produced by the given transaction.
+func (repo PostingRepository) FindByTransactionID(transactionID uuid.UUID) ([]entities.Posting, error) {
+	var postings []entities.Posting
+
+	result := repo.db.Where("transaction_id = ?", transactionID).Order("account ASC").Find(&postings)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return postings, nil
+}
+
+// AccountBalance returns the sum of the credits minus the sum of the debits of the wallet account, only postings
+// created at or before the given time are counted if it is set.
+func (repo PostingRepository) AccountBalance(walletID uuid.UUID, account string, at *time.Time) (int64, error) {
+	var total *int64
+
+	query := repo.db.Model(&entities.Posting{}).
+		Where("wallet_id = ? AND account = ?", walletID, account)
+	if at != nil {
+		query = query.Where("created_at <= ?", *at)
+	}
+
+	result := query.
+		Select("SUM(CASE WHEN direction = ? THEN amount ELSE -amount END)", entities.Credit).
+		Scan(&total)
+	if result.Error != nil {
+		return 0, result.Error
+	}
+
+	if total == nil {
+		return 0, nil
+	}
+
+	return *total, nil
+}
+
+// WithContext returns a copy of the repository running its queries with the given context.
+func (repo PostingRepository) WithContext(ctx context.Context) *PostingRepository {
+	return &PostingRepository{db: repo.db.WithContext(ctx)}
+}
+
+// NewPostingRepository returns PostingRepository instance.
+func NewPostingRepository(db *gorm.DB) *PostingRepository {
+	return &PostingRepository{db: db}
+}
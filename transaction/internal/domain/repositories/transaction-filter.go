@@ -0,0 +1,18 @@
+package repositories
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+// TransactionFilter describes which transactions should be listed and the page to return.
+type TransactionFilter struct {
+	WalletID   *uuid.UUID
+	Status     string
+	Action     string
+	SourceType string
+	From       *time.Time
+	To         *time.Time
+	After      *Cursor
+	Limit      int
+}
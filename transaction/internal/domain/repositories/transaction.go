@@ -1,12 +1,15 @@
 package repositories
 
 import (
+	"context"
 	"errors"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgconn"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 	"time"
 	"wallet/transaction/internal/domain/entities"
+	"wallet/transaction/internal/domain/vo"
 )
 
 type TransactionRepository struct {
@@ -16,6 +19,10 @@ type TransactionRepository struct {
 // Custom error for duplicate key
 var ErrDuplicateKey = errors.New("duplicate key value violates unique constraint")
 
+// ErrNotReversible is returned when the transaction is no longer done, e.g. it was reversed by another correction
+// since it was read.
+var ErrNotReversible = errors.New("transaction is not done or already reversed")
+
 // Save saves the transaction entity to the database.
 func (repo TransactionRepository) Save(transaction *entities.Transaction) error {
 	transaction.UpdatedAt = time.Now()
@@ -23,7 +30,8 @@ func (repo TransactionRepository) Save(transaction *entities.Transaction) error
 	return repo.db.Save(transaction).Error
 }
 
-// Create creates the transaction entity to the database if it does not exist.
+// Create creates the transaction entity to the database, returns ErrDuplicateKey if a transaction with the same
+// source type and external ID exists.
 func (repo TransactionRepository) Create(transaction *entities.Transaction) error {
 	transaction.UpdatedAt = time.Now()
 	err := repo.db.Create(transaction).Error
@@ -39,8 +47,42 @@ func (repo TransactionRepository) Create(transaction *entities.Transaction) erro
 	return nil
 }
 
+// SaveReversed saves the transaction cancelled by a correction if it is still done and not reversed in the database,
+// returns ErrNotReversible otherwise.
+func (repo TransactionRepository) SaveReversed(transaction *entities.Transaction) error {
+	transaction.UpdatedAt = time.Now()
+
+	result := repo.db.Model(&entities.Transaction{}).
+		Where("id = ? AND status = ? AND reversed_at IS NULL", transaction.ID, entities.Done).
+		Updates(map[string]interface{}{
+			"status":         transaction.Status,
+			"cancel_reason":  transaction.CancelReason,
+			"reversed_by_id": transaction.ReversedByID,
+			"reversed_at":    transaction.ReversedAt,
+			"processed_at":   transaction.ProcessedAt,
+			"updated_at":     transaction.UpdatedAt,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return ErrNotReversible
+	}
+
+	return nil
+}
+
+// NewTransactionsChannel is the notification channel on which the creation of new transactions is announced.
+const NewTransactionsChannel = "new_transactions"
+
+// NotifyNew announces the creation of a new transaction of the wallet to the listening workers.
+func (repo TransactionRepository) NotifyNew(walletID uuid.UUID) error {
+	return repo.db.Exec("SELECT pg_notify(?, ?)", NewTransactionsChannel, walletID.String()).Error
+}
+
 // FindByID finds a transaction by its ID in the database and returns the correction entity.
-func (repo TransactionRepository) FindByID(id string) (*entities.Transaction, error) {
+func (repo TransactionRepository) FindByID(id uuid.UUID) (*entities.Transaction, error) {
 	var tx entities.Transaction
 	if err := repo.db.First(&tx, "id = ?", id).Error; err != nil {
 		return nil, err
@@ -49,8 +91,18 @@ func (repo TransactionRepository) FindByID(id string) (*entities.Transaction, er
 	return &tx, nil
 }
 
+// FindByExternalID finds a transaction by its source type and the ID given by the source.
+func (repo TransactionRepository) FindByExternalID(sourceType string, externalID string) (*entities.Transaction, error) {
+	var tx entities.Transaction
+	if err := repo.db.First(&tx, "source_type = ? AND external_id = ?", sourceType, externalID).Error; err != nil {
+		return nil, err
+	}
+
+	return &tx, nil
+}
+
 // FindByIDs finds a transactions by list of IDs in the database and returns the correction entity.
-func (repo TransactionRepository) FindByIDs(ids []string) ([]entities.Transaction, error) {
+func (repo TransactionRepository) FindByIDs(ids []uuid.UUID) ([]entities.Transaction, error) {
 	var transactions []entities.Transaction
 	if err := repo.db.Where("id IN ?", ids).Find(&transactions).Error; err != nil {
 		return nil, err
@@ -59,6 +111,36 @@ func (repo TransactionRepository) FindByIDs(ids []string) ([]entities.Transactio
 	return transactions, nil
 }
 
+// FindReversedBy returns the transactions cancelled by the given compensating transactions, grouped by
+// the compensating transaction ID.
+func (repo TransactionRepository) FindReversedBy(compensationIDs []uuid.UUID) (map[uuid.UUID][]entities.Transaction, error) {
+	reversed := make(map[uuid.UUID][]entities.Transaction)
+	if len(compensationIDs) == 0 {
+		return reversed, nil
+	}
+
+	var transactions []entities.Transaction
+	if err := repo.db.Where("reversed_by_id IN ?", compensationIDs).Order("created_at ASC").Find(&transactions).Error; err != nil {
+		return nil, err
+	}
+
+	for _, tx := range transactions {
+		reversed[*tx.ReversedByID] = append(reversed[*tx.ReversedByID], tx)
+	}
+
+	return reversed, nil
+}
+
+// LockByIDs finds transactions by list of IDs and locks them until the end of the database transaction.
+func (repo TransactionRepository) LockByIDs(ids []uuid.UUID) ([]entities.Transaction, error) {
+	var transactions []entities.Transaction
+	if err := repo.db.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id IN ?", ids).Find(&transactions).Error; err != nil {
+		return nil, err
+	}
+
+	return transactions, nil
+}
+
 // GetNextTransaction Returns the most recent 'old' transaction for processing.
 func (repo TransactionRepository) GetNextTransaction() (*entities.Transaction, error) {
 	var transaction entities.Transaction
@@ -82,12 +164,14 @@ func (repo TransactionRepository) GetAllTransactions() ([]entities.Transaction,
 	return transactions, nil
 }
 
-// GetLastOddTransactions retrieves the most recent odd-numbered 'new' or 'done' transactions up to the specified limit.
-func (repo TransactionRepository) GetLastOddTransactions(limit int) ([]entities.Transaction, error) {
+// GetLastOddTransactions retrieves the most recent odd-numbered 'done' transactions of the wallet up to the specified
+// limit and locks them until the end of the database transaction. Transactions locked by another correction are skipped.
+func (repo TransactionRepository) GetLastOddTransactions(walletID uuid.UUID, limit int) ([]entities.Transaction, error) {
 	var transactions []entities.Transaction
 	err := repo.db.
 		Table("transactions").
-		Where("status IN (?)", entities.Done).
+		Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+		Where("wallet_id = ? AND status IN (?)", walletID, entities.Done).
 		Order("created_at DESC").
 		Limit(limit * 2).
 		Find(&transactions).Error
@@ -105,12 +189,49 @@ func (repo TransactionRepository) GetLastOddTransactions(limit int) ([]entities.
 	return result, nil
 }
 
-// CalculateBalance calculates the total balance from 'done' transactions.
-func (repo TransactionRepository) CalculateBalance() (int64, error) {
+// GetLastTransactionsBySourceType retrieves the most recent 'done' transactions of the wallet with the given source type
+// up to the specified limit and locks them until the end of the database transaction. Transactions locked by another
+// correction are skipped.
+func (repo TransactionRepository) GetLastTransactionsBySourceType(walletID uuid.UUID, sourceType string, limit int) ([]entities.Transaction, error) {
+	var transactions []entities.Transaction
+	err := repo.db.
+		Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+		Where("wallet_id = ? AND status = ? AND source_type = ?", walletID, entities.Done, sourceType).
+		Order("created_at DESC").
+		Limit(limit).
+		Find(&transactions).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return transactions, nil
+}
+
+// GetDoneTransactionsByIDs retrieves the 'done' transactions of the wallet with the given IDs up to the specified limit
+// and locks them until the end of the database transaction. Transactions locked by another correction are skipped.
+func (repo TransactionRepository) GetDoneTransactionsByIDs(walletID uuid.UUID, ids []uuid.UUID, limit int) ([]entities.Transaction, error) {
+	var transactions []entities.Transaction
+	err := repo.db.
+		Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+		Where("wallet_id = ? AND status = ? AND id IN ?", walletID, entities.Done, ids).
+		Order("created_at DESC").
+		Limit(limit).
+		Find(&transactions).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return transactions, nil
+}
+
+// CalculateBalance calculates the total balance of the wallet from 'done' transactions. Transactions reversed by
+// a correction are counted too, as their compensating transaction takes them back from the balance.
+func (repo TransactionRepository) CalculateBalance(walletID uuid.UUID) (int64, error) {
 	var totalAmount *int64
 
 	result := repo.db.Model(&entities.Transaction{}).
-		Where("status = ?", entities.Done).
+		Where("wallet_id = ?", walletID).
+		Where("status = ? OR reversed_at IS NOT NULL", entities.Done).
 		Select("SUM(amount)").
 		Scan(&totalAmount)
 
@@ -125,32 +246,202 @@ func (repo TransactionRepository) CalculateBalance() (int64, error) {
 	return *totalAmount, nil
 }
 
-// LockNewTransactions locks all new and frozen(transactions which have lockedAt time more than 1 min ago) transactions
-func (repo TransactionRepository) LockNewTransactions(lockUuid uuid.UUID) error {
+// CalculateBalanceAt calculates the balance change of the wallet from the transactions processed after the from time
+// and at or before the to time, all transactions processed up to the to time are counted if from is not set.
+// Transactions reversed by a correction are counted too, as they were applied to the balance until their compensating
+// transaction was processed.
+func (repo TransactionRepository) CalculateBalanceAt(walletID uuid.UUID, from *time.Time, to time.Time) (int64, error) {
+	var totalAmount *int64
+
+	query := repo.db.Model(&entities.Transaction{}).
+		Where("wallet_id = ? AND processed_at <= ?", walletID, to).
+		Where("status = ? OR reversed_at IS NOT NULL", entities.Done)
+	if from != nil {
+		query = query.Where("processed_at > ?", *from)
+	}
+
+	result := query.Select("SUM(amount)").Scan(&totalAmount)
+	if result.Error != nil {
+		return 0, result.Error
+	}
+
+	if totalAmount == nil {
+		return 0, nil
+	}
+
+	return *totalAmount, nil
+}
+
+// FindWalletsProcessedBetween returns IDs of the wallets which have transactions processed after the from time and
+// at or before the to time.
+func (repo TransactionRepository) FindWalletsProcessedBetween(from time.Time, to time.Time) ([]uuid.UUID, error) {
+	var walletIDs []uuid.UUID
+
+	result := repo.db.Model(&entities.Transaction{}).
+		Where("processed_at > ? AND processed_at <= ?", from, to).
+		Distinct().
+		Pluck("wallet_id", &walletIDs)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return walletIDs, nil
+}
+
+// FindWalletCurrency returns the currency of the first transaction of the wallet, which defines the wallet currency,
+// returns nil if the wallet has no transactions yet.
+func (repo TransactionRepository) FindWalletCurrency(walletID uuid.UUID) (*vo.Currency, error) {
+	var transaction entities.Transaction
+
+	if err := repo.db.Where("wallet_id = ?", walletID).Order("created_at ASC").Limit(1).First(&transaction).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &transaction.Currency, nil
+}
+
+// CountPendingTransactions counts the wallet transactions in 'new' or 'locked' status which are not processed yet.
+func (repo TransactionRepository) CountPendingTransactions(walletID uuid.UUID) (int64, error) {
+	var count int64
+
+	result := repo.db.Model(&entities.Transaction{}).
+		Where("wallet_id = ? AND status IN ?", walletID, []string{entities.New, entities.Locked}).
+		Count(&count)
+
+	if result.Error != nil {
+		return 0, result.Error
+	}
+
+	return count, nil
+}
+
+// CountByStatus counts the transactions of all wallets in each of the given statuses, statuses without transactions
+// are counted as zero.
+func (repo TransactionRepository) CountByStatus(statuses []string) (map[string]int64, error) {
+	var rows []struct {
+		Status string
+		Count  int64
+	}
+
+	result := repo.db.Model(&entities.Transaction{}).
+		Select("status, count(*) AS count").
+		Where("status IN ?", statuses).
+		Group("status").
+		Scan(&rows)
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	counts := make(map[string]int64, len(statuses))
+	for _, status := range statuses {
+		counts[status] = 0
+	}
+	for _, row := range rows {
+		counts[row.Status] = row.Count
+	}
+
+	return counts, nil
+}
+
+// ClaimedTransaction is a transaction claimed by a worker. A blocked transaction must not be processed yet, as an
+// earlier transaction of its wallet is not processed and is not claimed by the same worker.
+type ClaimedTransaction struct {
+	entities.Transaction `gorm:"embedded"`
+	Blocked              bool
+}
+
+// ClaimBatch locks up to limit of the oldest new, frozen (locked more than 1 min ago) and already locked by the given
+// lock transactions for the given lock and returns them ordered by created at ASC. Rows locked by other database
+// transactions are skipped, so concurrent workers claim disjoint batches without waiting for each other. Every
+// transaction is flagged as blocked if its wallet has an earlier pending transaction outside the claimed ones which
+// is not held by the given lock.
+func (repo TransactionRepository) ClaimBatch(lockUuid uuid.UUID, limit int) ([]ClaimedTransaction, error) {
+	var transactions []ClaimedTransaction
+
 	now := time.Now()
 	threshold := now.Add(-1 * time.Minute)
 
+	// the outer query sees the transactions as they were before the update, so the claimed ones are excluded by ID
+	result := repo.db.Raw(`WITH claimed AS (
+			UPDATE transactions SET status = ?, lock_uuid = ?, locked_at = ?
+			WHERE id IN (
+				SELECT id FROM transactions
+				WHERE status = ? OR (status = ? AND (locked_at < ? OR lock_uuid = ?))
+				ORDER BY created_at ASC
+				LIMIT ?
+				FOR UPDATE SKIP LOCKED
+			)
+			RETURNING *
+		)
+		SELECT claimed.*, EXISTS (
+			SELECT 1 FROM transactions earlier
+			WHERE earlier.wallet_id = claimed.wallet_id AND earlier.created_at < claimed.created_at
+				AND (earlier.status = ? OR (earlier.status = ? AND earlier.lock_uuid <> ?))
+				AND earlier.id NOT IN (SELECT id FROM claimed)
+		) AS blocked
+		FROM claimed ORDER BY created_at ASC`,
+		entities.Locked, lockUuid, now, entities.New, entities.Locked, threshold, lockUuid, limit,
+		entities.New, entities.Locked, lockUuid,
+	).Scan(&transactions)
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return transactions, nil
+}
+
+// ReleaseClaims returns the transactions still locked by the given lock to the new status, so they can be claimed
+// right away instead of after the frozen lock timeout, and returns the number of released transactions.
+func (repo TransactionRepository) ReleaseClaims(lockUuid uuid.UUID) (int, error) {
 	result := repo.db.Model(&entities.Transaction{}).
-		Where("(status = ?) OR (locked_at < ? AND status = ?)", "new", threshold, "locked").
+		Where("status = ? AND lock_uuid = ?", entities.Locked, lockUuid).
 		Updates(map[string]interface{}{
-			"status":    "locked",
-			"lock_uuid": lockUuid,
-			"locked_at": now,
+			"status":    entities.New,
+			"lock_uuid": nil,
+			"locked_at": nil,
 		})
 
 	if result.Error != nil {
-		return result.Error
+		return 0, result.Error
 	}
 
-	return nil
+	return int(result.RowsAffected), nil
 }
 
-// GetLockedTransactions returns all processing transactions ordered by created at ASC
-func (repo TransactionRepository) GetLockedTransactions() ([]entities.Transaction, error) {
+// List returns a page of transactions matching the filter, ordered from the newest to the oldest.
+func (repo TransactionRepository) List(filter TransactionFilter) ([]entities.Transaction, error) {
 	var transactions []entities.Transaction
 
-	result := repo.db.Where("status = ?", "locked").
-		Order("created_at ASC").
+	query := repo.db.Model(&entities.Transaction{})
+	if filter.WalletID != nil {
+		query = query.Where("wallet_id = ?", *filter.WalletID)
+	}
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+	if filter.Action != "" {
+		query = query.Where("action = ?", filter.Action)
+	}
+	if filter.SourceType != "" {
+		query = query.Where("source_type = ?", filter.SourceType)
+	}
+	if filter.From != nil {
+		query = query.Where("created_at >= ?", *filter.From)
+	}
+	if filter.To != nil {
+		query = query.Where("created_at < ?", *filter.To)
+	}
+	if filter.After != nil {
+		query = query.Where("(created_at, id) < (?, ?)", filter.After.CreatedAt, filter.After.ID)
+	}
+
+	result := query.Order("created_at DESC, id DESC").
+		Limit(filter.Limit).
 		Find(&transactions)
 
 	if result.Error != nil {
@@ -172,6 +463,11 @@ func (repo TransactionRepository) FindAll() ([]entities.Transaction, error) {
 	return transactions, nil
 }
 
+// WithContext returns a copy of the repository running its queries with the given context.
+func (repo TransactionRepository) WithContext(ctx context.Context) *TransactionRepository {
+	return &TransactionRepository{db: repo.db.WithContext(ctx)}
+}
+
 // NewTransactionRepository returns TransactionRepository instance.
 func NewTransactionRepository(db *gorm.DB) *TransactionRepository {
 	return &TransactionRepository{db: db}
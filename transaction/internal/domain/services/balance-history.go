@@ -0,0 +1,96 @@
+package services
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+	"time"
+	"wallet/transaction/internal/domain/entities"
+	"wallet/transaction/internal/domain/repositories"
+	"wallet/transaction/internal/domain/vo"
+)
+
+// HistoryCalculator calculates the balance changes from the processed transactions.
+type HistoryCalculator interface {
+	CalculateBalanceAt(walletID uuid.UUID, from *time.Time, to time.Time) (int64, error)
+	FindWalletCurrency(walletID uuid.UUID) (*vo.Currency, error)
+}
+
+// SnapshotStorage balance snapshots storage.
+type SnapshotStorage interface {
+	Create(snapshot *entities.BalanceSnapshot) error
+	FindLatest(walletID uuid.UUID, at time.Time) (*entities.BalanceSnapshot, error)
+}
+
+// BalanceHistory returns the balance of a wallet at any point in time.
+type BalanceHistory struct {
+	calculator HistoryCalculator
+	snapshots  SnapshotStorage
+}
+
+// At returns the balance of the wallet at the given time, calculated from the latest snapshot taken before that time
+// and the transactions processed after the snapshot.
+func (h BalanceHistory) At(walletID uuid.UUID, at time.Time) (*entities.Balance, error) {
+	snapshot, err := h.snapshots.FindLatest(walletID, at)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot find balance snapshot")
+	}
+
+	var (
+		from  *time.Time
+		value int64
+	)
+	if snapshot != nil {
+		from = &snapshot.TakenAt
+		value = snapshot.Value.Cents
+	}
+
+	delta, err := h.calculator.CalculateBalanceAt(walletID, from, at)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot calculate balance")
+	}
+
+	currency := vo.DefaultCurrency
+	walletCurrency, err := h.calculator.FindWalletCurrency(walletID)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot calculate balance")
+	}
+	if walletCurrency != nil {
+		currency = *walletCurrency
+	}
+
+	return entities.NewBalance(walletID, vo.NewTotalAmount(value+delta), currency), nil
+}
+
+// Snapshot saves the balance of the wallet at the given time.
+func (h BalanceHistory) Snapshot(walletID uuid.UUID, at time.Time) error {
+	balance, err := h.At(walletID, at)
+	if err != nil {
+		return err
+	}
+
+	return errors.Wrap(h.snapshots.Create(entities.NewBalanceSnapshot(balance, at)), "cannot save balance snapshot")
+}
+
+// WithContext returns a copy of the history running its queries with the given context, injected storages which
+// cannot run with a context are kept as is.
+func (h BalanceHistory) WithContext(ctx context.Context) BalanceHistory {
+	history := h
+	if calculator, ok := h.calculator.(*repositories.TransactionRepository); ok {
+		history.calculator = calculator.WithContext(ctx)
+	}
+	if snapshots, ok := h.snapshots.(*repositories.BalanceSnapshotRepository); ok {
+		history.snapshots = snapshots.WithContext(ctx)
+	}
+
+	return history
+}
+
+// NewBalanceHistory returns BalanceHistory instance.
+func NewBalanceHistory(db *gorm.DB) BalanceHistory {
+	return BalanceHistory{
+		calculator: repositories.NewTransactionRepository(db),
+		snapshots:  repositories.NewBalanceSnapshotRepository(db),
+	}
+}
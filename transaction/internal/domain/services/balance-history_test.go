@@ -0,0 +1,97 @@
+package services_test
+
+import (
+	"context"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"time"
+	"wallet/transaction/internal/domain/entities"
+	"wallet/transaction/internal/domain/repositories"
+	"wallet/transaction/internal/domain/services"
+)
+
+var _ = Describe("Balance history", func() {
+	var (
+		transactionProcessor services.TransactionProcessor
+		balanceHistory       services.BalanceHistory
+		walletID             uuid.UUID
+	)
+
+	process := func(amount int) time.Time {
+		GinkgoHelper()
+
+		Expect(transactionProcessor.Execute(context.Background(), createTransaction(walletID, amount))).To(Succeed())
+		processedAt := time.Now()
+		time.Sleep(10 * time.Millisecond)
+
+		return processedAt
+	}
+
+	BeforeEach(func() {
+		walletID = uuid.New()
+		transactionProcessor = services.NewTransactionProcessor(DB)
+		balanceHistory = services.NewBalanceHistory(DB)
+	})
+
+	Context("transactions were processed one after another", func() {
+		var afterFirst, afterSecond time.Time
+
+		BeforeEach(func() {
+			afterFirst = process(100)
+			afterSecond = process(-30)
+		})
+
+		It("balance before the first transaction should be zero", func() {
+			balance, err := balanceHistory.At(walletID, afterFirst.Add(-time.Hour))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(balance.Value.Cents).To(BeZero())
+		})
+
+		It("balance should include only the transactions processed before the time", func() {
+			balance, err := balanceHistory.At(walletID, afterFirst)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(balance.Value.Cents).To(Equal(int64(100)))
+
+			balance, err = balanceHistory.At(walletID, afterSecond)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(balance.Value.Cents).To(Equal(int64(70)))
+		})
+
+		When("a snapshot is taken after the first transaction", func() {
+			BeforeEach(func() {
+				Expect(balanceHistory.Snapshot(walletID, afterFirst)).To(Succeed())
+			})
+
+			It("balance should be calculated from the snapshot", func() {
+				snapshot, err := repositories.NewBalanceSnapshotRepository(DB).FindLatest(walletID, afterSecond)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(snapshot.Value.Cents).To(Equal(int64(100)))
+
+				balance, err := balanceHistory.At(walletID, afterSecond)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(balance.Value.Cents).To(Equal(int64(70)))
+			})
+		})
+
+		When("the last transaction is reversed by a correction", func() {
+			var beforeCorrection time.Time
+
+			BeforeEach(func() {
+				beforeCorrection = time.Now()
+				policy := services.DefaultCorrectionPolicy()
+				policy.Strategy = services.SourceTypeStrategy
+				policy.SourceType = entities.Game
+				policy.BatchSize = 1
+				_, err := services.NewCorrectionProcessor(DB, policy).Execute(walletID)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("balance before the correction should be kept", func() {
+				balance, err := balanceHistory.At(walletID, beforeCorrection)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(balance.Value.Cents).To(Equal(int64(70)))
+			})
+		})
+	})
+})
@@ -1,6 +1,8 @@
 package services
 
 import (
+	"context"
+	"github.com/google/uuid"
 	"github.com/pkg/errors"
 	"gorm.io/gorm"
 	"wallet/transaction/internal/domain/entities"
@@ -10,16 +12,19 @@ import (
 
 // BalanceCalculator actual balance calculator
 type BalanceCalculator interface {
-	CalculateBalance() (int64, error)
+	CalculateBalance(walletID uuid.UUID) (int64, error)
+	FindWalletCurrency(walletID uuid.UUID) (*vo.Currency, error)
 }
 
+// BalanceProvider returns the balance of a wallet, lazily creating it from the wallet transactions.
 type BalanceProvider struct {
 	repo       *repositories.BalanceRepository
 	calculator BalanceCalculator
 }
 
-func (b BalanceProvider) Provide() (*entities.Balance, error) {
-	balance, err := b.repo.Get()
+// Provide returns the balance of the given wallet, calculating and saving it if it does not exist yet.
+func (b BalanceProvider) Provide(walletID uuid.UUID) (*entities.Balance, error) {
+	balance, err := b.repo.Get(walletID)
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot provide balance")
 	}
@@ -28,12 +33,11 @@ func (b BalanceProvider) Provide() (*entities.Balance, error) {
 		return balance, nil
 	}
 
-	calculatedValue, err := b.calculator.CalculateBalance()
+	balance, err = b.calculate(walletID)
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot provide balance")
 	}
 
-	balance = entities.NewBalance(vo.NewTotalAmount(calculatedValue))
 	err = b.repo.Save(balance)
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot provide balance")
@@ -42,6 +46,58 @@ func (b BalanceProvider) Provide() (*entities.Balance, error) {
 	return balance, err
 }
 
+// Current returns the balance of the given wallet, calculating it without saving if it does not exist yet,
+// so reading the balance of a new wallet does not fix its currency.
+func (b BalanceProvider) Current(walletID uuid.UUID) (*entities.Balance, error) {
+	balance, err := b.repo.Get(walletID)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot provide balance")
+	}
+
+	if balance != nil {
+		return balance, nil
+	}
+
+	balance, err = b.calculate(walletID)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot provide balance")
+	}
+
+	return balance, nil
+}
+
+// calculate creates the balance entity from the wallet transactions, the wallet currency is the currency of its
+// first transaction.
+func (b BalanceProvider) calculate(walletID uuid.UUID) (*entities.Balance, error) {
+	calculatedValue, err := b.calculator.CalculateBalance(walletID)
+	if err != nil {
+		return nil, err
+	}
+
+	currency := vo.DefaultCurrency
+	walletCurrency, err := b.calculator.FindWalletCurrency(walletID)
+	if err != nil {
+		return nil, err
+	}
+	if walletCurrency != nil {
+		currency = *walletCurrency
+	}
+
+	return entities.NewBalance(walletID, vo.NewTotalAmount(calculatedValue), currency), nil
+}
+
+// WithContext returns a copy of the provider running its queries with the given context, an injected calculator
+// which cannot run with a context is kept as is.
+func (b BalanceProvider) WithContext(ctx context.Context) BalanceProvider {
+	provider := BalanceProvider{repo: b.repo.WithContext(ctx), calculator: b.calculator}
+	if calculator, ok := b.calculator.(*repositories.TransactionRepository); ok {
+		provider.calculator = calculator.WithContext(ctx)
+	}
+
+	return provider
+}
+
+// NewBalanceProvider returns BalanceProvider instance.
 func NewBalanceProvider(db *gorm.DB) BalanceProvider {
 	return BalanceProvider{
 		repo:       repositories.NewBalanceRepository(db),
@@ -1,6 +1,8 @@
 package services
 
 import (
+	"context"
+	"github.com/google/uuid"
 	"github.com/pkg/errors"
 	"gorm.io/gorm"
 	"wallet/transaction/internal/domain/entities"
@@ -11,7 +13,7 @@ import (
 // BalanceRepository balance storage.
 type BalanceRepository interface {
 	Save(balance *entities.Balance) error
-	Get() (*entities.Balance, error)
+	Get(walletID uuid.UUID) (*entities.Balance, error)
 }
 
 // Balance service
@@ -23,14 +25,22 @@ type Balance struct {
 // ErrNegativeBalance error.
 var ErrNegativeBalance = errors.New("TotalAmount cannot be negative")
 
-// UpdateBalance updates the current balance by adding the specified amount,
-// returns an error if the balance becomes negative or if any operation fails.
-func (b *Balance) UpdateBalance(amount vo.Amount) error {
-	balance, err := b.balanceProvider.Provide()
+// ErrCurrencyMismatch error.
+var ErrCurrencyMismatch = errors.New("currency differs from the wallet currency")
+
+// UpdateBalance updates the current balance of the wallet by adding the specified amount,
+// returns an error if the balance becomes negative, the currency differs from the wallet currency
+// or if any operation fails.
+func (b *Balance) UpdateBalance(walletID uuid.UUID, amount vo.Amount, currency vo.Currency) error {
+	balance, err := b.balanceProvider.Provide(walletID)
 	if err != nil {
 		return errors.Wrap(err, "cannot update balance")
 	}
 
+	if balance.Currency != currency {
+		return ErrCurrencyMismatch
+	}
+
 	balance.Value = balance.Value.AddAmount(amount)
 	if balance.Value.LessThanZero() && amount.LessThenZero() {
 		return ErrNegativeBalance
@@ -39,18 +49,33 @@ func (b *Balance) UpdateBalance(amount vo.Amount) error {
 	return b.repo.Save(balance)
 }
 
-// ForceUpdateBalance updates the current balance by adding the specified amount
+// ForceUpdateBalance updates the current balance of the wallet by adding the specified amount
 // and saves the updated balance without checking for negative values.
-func (b *Balance) ForceUpdateBalance(amount vo.Amount) error {
-	balance, err := b.balanceProvider.Provide()
+func (b *Balance) ForceUpdateBalance(walletID uuid.UUID, amount vo.Amount, currency vo.Currency) error {
+	balance, err := b.balanceProvider.Provide(walletID)
 	if err != nil {
 		return errors.Wrap(err, "cannot update balance")
 	}
+
+	if balance.Currency != currency {
+		return ErrCurrencyMismatch
+	}
 	balance.Value = balance.Value.AddAmount(amount)
 
 	return b.repo.Save(balance)
 }
 
+// WithContext returns a copy of the service running its queries with the given context, an injected repository
+// which cannot run with a context is kept as is.
+func (b *Balance) WithContext(ctx context.Context) *Balance {
+	balance := &Balance{repo: b.repo, balanceProvider: b.balanceProvider.WithContext(ctx)}
+	if repo, ok := b.repo.(*repositories.BalanceRepository); ok {
+		balance.repo = repo.WithContext(ctx)
+	}
+
+	return balance
+}
+
 // NewBalanceService returns an instance of Balance service.
 func NewBalanceService(db *gorm.DB) *Balance {
 	return &Balance{
@@ -1,6 +1,7 @@
 package services_test
 
 import (
+	"errors"
 	"github.com/google/uuid"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -13,10 +14,12 @@ import (
 var _ = Describe("check balance initialization and providing", func() {
 	var (
 		balanceProvider services.BalanceProvider
+		walletID        uuid.UUID
 	)
 
 	BeforeEach(func() {
 		balanceProvider = services.NewBalanceProvider(DB)
+		walletID = uuid.New()
 	})
 
 	Context("balance does not exists", func() {
@@ -28,7 +31,7 @@ var _ = Describe("check balance initialization and providing", func() {
 				)
 
 				BeforeEach(func() {
-					balance, err = balanceProvider.Provide()
+					balance, err = balanceProvider.Provide(walletID)
 					Expect(err).ToNot(HaveOccurred())
 				})
 
@@ -46,17 +49,17 @@ var _ = Describe("check balance initialization and providing", func() {
 
 			BeforeEach(func() {
 				repo := repositories.NewTransactionRepository(DB)
-				transaction := entities.NewTransaction(uuid.New().String(), vo.NewAmount(10), entities.Win, entities.Game)
+				transaction := entities.NewTransaction(uuid.New().String(), walletID, vo.NewAmount(10), vo.DefaultCurrency, entities.Win, entities.Game)
 				transaction.MarkAsDone()
 
 				err := repo.Create(transaction)
 				Expect(err).ToNot(HaveOccurred())
 
-				balance, err = balanceProvider.Provide()
+				balance, err = balanceProvider.Provide(walletID)
 			})
 
 			BeforeEach(func() {
-				balance, err = balanceProvider.Provide()
+				balance, err = balanceProvider.Provide(walletID)
 				Expect(err).ToNot(HaveOccurred())
 			})
 
@@ -65,6 +68,24 @@ var _ = Describe("check balance initialization and providing", func() {
 				Expect(balance.Value.Value()).To(Equal(int64(10)))
 			})
 		})
+		Context("system has done transactions of another wallet", func() {
+			var (
+				balance *entities.Balance
+				err     error
+			)
+
+			BeforeEach(func() {
+				_ = createDoneTransaction(uuid.New(), 10)
+
+				balance, err = balanceProvider.Provide(walletID)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("balance should be zero", func() {
+				Expect(balance).ToNot(BeNil())
+				Expect(balance.Value.Value()).To(Equal(int64(0)))
+			})
+		})
 	})
 
 	Context("balance already exists", func() {
@@ -75,12 +96,12 @@ var _ = Describe("check balance initialization and providing", func() {
 
 		BeforeEach(func() {
 			repo := repositories.NewBalanceRepository(DB)
-			err := repo.Save(entities.NewBalance(vo.NewTotalAmount(int64(11))))
+			err := repo.Save(entities.NewBalance(walletID, vo.NewTotalAmount(int64(11)), vo.DefaultCurrency))
 			Expect(err).ToNot(HaveOccurred())
 		})
 
 		BeforeEach(func() {
-			balance, err = balanceProvider.Provide()
+			balance, err = balanceProvider.Provide(walletID)
 			Expect(err).ToNot(HaveOccurred())
 		})
 
@@ -94,30 +115,38 @@ var _ = Describe("check balance updating", func() {
 	var (
 		balanceService  *services.Balance
 		balanceProvider services.BalanceProvider
+		walletID        uuid.UUID
 	)
 
 	BeforeEach(func() {
 		balanceService = services.NewBalanceService(DB)
 		balanceProvider = services.NewBalanceProvider(DB)
+		walletID = uuid.New()
 	})
 
 	Context("balance are zero", func() {
 		When("positive transaction received", func() {
 			BeforeEach(func() {
-				err := balanceService.UpdateBalance(vo.NewAmount(10))
+				err := balanceService.UpdateBalance(walletID, vo.NewAmount(10), vo.DefaultCurrency)
 				Expect(err).ToNot(HaveOccurred())
 			})
 
 			It("should increment balance", func() {
-				balance, err := balanceProvider.Provide()
+				balance, err := balanceProvider.Provide(walletID)
 				Expect(err).ToNot(HaveOccurred())
 				Expect(balance.Value.Value()).To(Equal(int64(10)))
 			})
+
+			It("should not change balance of another wallet", func() {
+				balance, err := balanceProvider.Provide(uuid.New())
+				Expect(err).ToNot(HaveOccurred())
+				Expect(balance.Value.Value()).To(Equal(int64(0)))
+			})
 		})
 		When("negatiove transaction received", func() {
 			var err error
 			BeforeEach(func() {
-				err = balanceService.UpdateBalance(vo.NewAmount(-10))
+				err = balanceService.UpdateBalance(walletID, vo.NewAmount(-10), vo.DefaultCurrency)
 			})
 
 			It("error should be rised", func() {
@@ -125,10 +154,50 @@ var _ = Describe("check balance updating", func() {
 			})
 
 			It("should not increment balance", func() {
-				balance, err := balanceProvider.Provide()
+				balance, err := balanceProvider.Provide(walletID)
 				Expect(err).ToNot(HaveOccurred())
 				Expect(balance.Value.Value()).To(Equal(int64(0)))
 			})
 		})
 	})
+
+	Context("balance was read by two processes", func() {
+		var first, second *entities.Balance
+
+		BeforeEach(func() {
+			repo := repositories.NewBalanceRepository(DB)
+			Expect(repo.Save(entities.NewBalance(walletID, vo.NewTotalAmount(100), vo.DefaultCurrency))).To(Succeed())
+
+			var err error
+			first, err = repo.Get(walletID)
+			Expect(err).ToNot(HaveOccurred())
+			second, err = repo.Get(walletID)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		When("both processes update the balance", func() {
+			var err error
+
+			BeforeEach(func() {
+				repo := repositories.NewBalanceRepository(DB)
+
+				first.Value = first.Value.AddAmount(vo.NewAmount(10))
+				Expect(repo.Save(first)).To(Succeed())
+
+				second.Value = second.Value.AddAmount(vo.NewAmount(20))
+				err = repo.Save(second)
+			})
+
+			It("the second update should fail", func() {
+				Expect(errors.Is(err, repositories.ErrConcurrentModification)).To(BeTrue())
+			})
+
+			It("the first update should be kept", func() {
+				balance, err := balanceProvider.Provide(walletID)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(balance.Value.Value()).To(Equal(int64(110)))
+				Expect(balance.Version).To(Equal(int64(1)))
+			})
+		})
+	})
 })
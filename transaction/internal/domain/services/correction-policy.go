@@ -0,0 +1,132 @@
+package services
+
+import (
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/spf13/viper"
+	"strings"
+	"time"
+	"wallet/transaction/internal/domain/entities"
+)
+
+// OddStrategy selects every second transaction starting from the most recent one.
+const OddStrategy = "odd"
+
+// SourceTypeStrategy selects the most recent transactions of the configured source type.
+const SourceTypeStrategy = "source_type"
+
+// IDsStrategy selects the transactions with the configured IDs.
+const IDsStrategy = "ids"
+
+// NoneStrategy disables corrections.
+const NoneStrategy = "none"
+
+// ErrInvalidCorrectionPolicy is returned when the correction policy configuration cannot be used.
+var ErrInvalidCorrectionPolicy = errors.New("invalid correction policy")
+
+// CorrectionPolicy describes how often the wallet corrections are executed and which transactions they cancel.
+type CorrectionPolicy struct {
+	Interval       time.Duration
+	BatchSize      int
+	Strategy       string
+	SourceType     string
+	TransactionIDs []uuid.UUID
+}
+
+// CorrectionTransactionFinder finds the done transactions of the wallet which can be cancelled by a correction.
+type CorrectionTransactionFinder interface {
+	GetLastOddTransactions(walletID uuid.UUID, limit int) ([]entities.Transaction, error)
+	GetLastTransactionsBySourceType(walletID uuid.UUID, sourceType string, limit int) ([]entities.Transaction, error)
+	GetDoneTransactionsByIDs(walletID uuid.UUID, ids []uuid.UUID, limit int) ([]entities.Transaction, error)
+}
+
+// IsDisabled returns true if corrections should not be executed.
+func (p CorrectionPolicy) IsDisabled() bool {
+	return p.Strategy == NoneStrategy
+}
+
+// SelectTransactions returns the transactions of the wallet which should be cancelled according to the strategy.
+func (p CorrectionPolicy) SelectTransactions(finder CorrectionTransactionFinder, walletID uuid.UUID) ([]entities.Transaction, error) {
+	switch p.Strategy {
+	case OddStrategy:
+		return finder.GetLastOddTransactions(walletID, p.BatchSize)
+	case SourceTypeStrategy:
+		return finder.GetLastTransactionsBySourceType(walletID, p.SourceType, p.BatchSize)
+	case IDsStrategy:
+		return finder.GetDoneTransactionsByIDs(walletID, p.TransactionIDs, p.BatchSize)
+	default:
+		return nil, nil
+	}
+}
+
+// Validate checks that the policy can be executed.
+func (p CorrectionPolicy) Validate() error {
+	if p.IsDisabled() {
+		return nil
+	}
+
+	if p.Interval <= 0 {
+		return fmt.Errorf("%w: interval must be positive", ErrInvalidCorrectionPolicy)
+	}
+	if p.BatchSize <= 0 {
+		return fmt.Errorf("%w: batch size must be positive", ErrInvalidCorrectionPolicy)
+	}
+
+	switch p.Strategy {
+	case OddStrategy:
+	case SourceTypeStrategy:
+		if p.SourceType == "" {
+			return fmt.Errorf("%w: source type is required by the %s strategy", ErrInvalidCorrectionPolicy, p.Strategy)
+		}
+	case IDsStrategy:
+		if len(p.TransactionIDs) == 0 {
+			return fmt.Errorf("%w: transaction IDs are required by the %s strategy", ErrInvalidCorrectionPolicy, p.Strategy)
+		}
+	default:
+		return fmt.Errorf("%w: unknown strategy %q", ErrInvalidCorrectionPolicy, p.Strategy)
+	}
+
+	return nil
+}
+
+// DefaultCorrectionPolicy returns the policy which cancels the last 10 odd transactions of the wallet every 10 minutes.
+func DefaultCorrectionPolicy() CorrectionPolicy {
+	return CorrectionPolicy{
+		Interval:  10 * time.Minute,
+		BatchSize: 10,
+		Strategy:  OddStrategy,
+	}
+}
+
+// LoadCorrectionPolicy reads the correction policy from the configuration, unset values are taken from
+// the default policy.
+func LoadCorrectionPolicy() (CorrectionPolicy, error) {
+	policy := DefaultCorrectionPolicy()
+
+	if viper.IsSet("correction.interval") {
+		policy.Interval = viper.GetDuration("correction.interval")
+	}
+	if viper.IsSet("correction.batch_size") {
+		policy.BatchSize = viper.GetInt("correction.batch_size")
+	}
+	if strategy := viper.GetString("correction.strategy"); strategy != "" {
+		policy.Strategy = strategy
+	}
+	policy.SourceType = viper.GetString("correction.source_type")
+
+	for _, id := range strings.Split(viper.GetString("correction.transaction_ids"), ",") {
+		id = strings.TrimSpace(id)
+		if id == "" {
+			continue
+		}
+
+		transactionID, err := uuid.Parse(id)
+		if err != nil {
+			return policy, fmt.Errorf("%w: transaction ID %q: %v", ErrInvalidCorrectionPolicy, id, err)
+		}
+		policy.TransactionIDs = append(policy.TransactionIDs, transactionID)
+	}
+
+	return policy, policy.Validate()
+}
@@ -0,0 +1,60 @@
+package services_test
+
+import (
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/viper"
+	"time"
+	"wallet/transaction/internal/domain/entities"
+	"wallet/transaction/internal/domain/services"
+)
+
+var _ = Describe("Correction policy", func() {
+	DescribeTable("invalid policy should be rejected",
+		func(modify func(policy *services.CorrectionPolicy)) {
+			policy := services.DefaultCorrectionPolicy()
+			modify(&policy)
+
+			Expect(policy.Validate()).To(MatchError(services.ErrInvalidCorrectionPolicy))
+		},
+		Entry("unknown strategy", func(policy *services.CorrectionPolicy) { policy.Strategy = "even" }),
+		Entry("zero interval", func(policy *services.CorrectionPolicy) { policy.Interval = 0 }),
+		Entry("zero batch size", func(policy *services.CorrectionPolicy) { policy.BatchSize = 0 }),
+		Entry("source type strategy without source type", func(policy *services.CorrectionPolicy) {
+			policy.Strategy = services.SourceTypeStrategy
+		}),
+		Entry("IDs strategy without IDs", func(policy *services.CorrectionPolicy) { policy.Strategy = services.IDsStrategy }),
+	)
+
+	Context("policy is configured", func() {
+		var transactionID uuid.UUID
+
+		BeforeEach(func() {
+			transactionID = uuid.New()
+
+			viper.Set("correction.interval", "1m")
+			viper.Set("correction.batch_size", "5")
+			viper.Set("correction.strategy", services.IDsStrategy)
+			viper.Set("correction.source_type", entities.Payment)
+			viper.Set("correction.transaction_ids", transactionID.String()+", ")
+
+			DeferCleanup(func() {
+				for _, key := range []string{"interval", "batch_size", "strategy", "source_type", "transaction_ids"} {
+					viper.Set("correction."+key, nil)
+				}
+			})
+		})
+
+		It("should be loaded from the configuration", func() {
+			policy, err := services.LoadCorrectionPolicy()
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(policy.Interval).To(Equal(time.Minute))
+			Expect(policy.BatchSize).To(Equal(5))
+			Expect(policy.Strategy).To(Equal(services.IDsStrategy))
+			Expect(policy.SourceType).To(Equal(entities.Payment))
+			Expect(policy.TransactionIDs).To(Equal([]uuid.UUID{transactionID}))
+		})
+	})
+})
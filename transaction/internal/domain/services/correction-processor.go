@@ -7,6 +7,7 @@ import (
 	"wallet/transaction/internal/domain/entities"
 	"wallet/transaction/internal/domain/repositories"
 	"wallet/transaction/internal/domain/vo"
+	"wallet/transaction/internal/infrastructure/metrics"
 )
 
 // CorrectionProcessor handles the creation and initialization of new corrections,
@@ -14,50 +15,135 @@ import (
 type CorrectionProcessor struct {
 	txRepo         *repositories.TransactionRepository
 	correctionRepo *repositories.CorrectionRepository
+	runRepo        *repositories.CorrectionRunRepository
+	policy         CorrectionPolicy
 }
 
-// Execute retrieves the last 10 odd-numbered transactions cancel it and add new transaction with inversed sum of cancelled transactions
-func (c CorrectionProcessor) Execute() error {
-	doomedTransactions, err := c.txRepo.GetLastOddTransactions(10)
+// Reversal is the outcome of a correction run: the run, the transactions it reversed and their compensating
+// transaction, which is nil if the reversed amounts sum up to zero.
+type Reversal struct {
+	Run          *entities.CorrectionRun
+	Transactions []entities.Transaction
+	Compensation *entities.Transaction
+}
+
+// Record reports the reversal to the metrics, it must be called once the database transaction is committed, so
+// a rolled back correction is not counted.
+func (r Reversal) Record() {
+	metrics.CorrectionRuns.WithLabelValues(r.Run.Kind).Inc()
+	for _, tx := range r.Transactions {
+		metrics.TransactionsCancelled.With(metrics.TransactionLabels(tx.SourceType, tx.Action)).Inc()
+	}
+}
+
+// Execute retrieves the transactions of the wallet selected by the correction policy cancel it and add new transaction
+// with inversed sum of cancelled transactions. The transactions are reversed currency by currency, every currency is
+// compensated and recorded in its own run. Returns the reversal of every currency.
+func (c CorrectionProcessor) Execute(walletID uuid.UUID) ([]Reversal, error) {
+	doomedTransactions, err := c.policy.SelectTransactions(c.txRepo, walletID)
 	if err != nil {
-		return errors.Wrap(err, "couldn't get last odd transactions")
+		return nil, errors.Wrap(err, "couldn't select transactions")
 	}
 
-	ids := make([]string, len(doomedTransactions))
-	delta := vo.NewAmount(0)
-	for i, tx := range doomedTransactions {
-		ids[i] = tx.ID
-		delta = delta.Add(tx.Amount)
-		tx.MarkAsCancelled()
-		err := c.txRepo.Save(&tx)
+	var reversals []Reversal
+	for _, transactions := range groupByCurrency(doomedTransactions) {
+		run := entities.NewCorrectionRun(entities.Automatic, walletID)
+		reversal, err := reverseTransactions(c.txRepo, run, transactions)
 		if err != nil {
-			return errors.Wrap(err, "unable to save doomed transaction")
+			return nil, err
 		}
+
+		run.Finish()
+		err = c.runRepo.Save(run)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to save correction run")
+		}
+		reversals = append(reversals, *reversal)
 	}
 
-	if delta.IsZero() {
-		return nil
+	return reversals, nil
+}
+
+// groupByCurrency splits the transactions by currency keeping their order, the groups are ordered by the first
+// transaction in the currency.
+func groupByCurrency(transactions []entities.Transaction) [][]entities.Transaction {
+	var currencies []vo.Currency
+	byCurrency := make(map[vo.Currency][]entities.Transaction)
+	for _, tx := range transactions {
+		if _, ok := byCurrency[tx.Currency]; !ok {
+			currencies = append(currencies, tx.Currency)
+		}
+		byCurrency[tx.Currency] = append(byCurrency[tx.Currency], tx)
 	}
 
-	delta = delta.Inverse()
-	action := entities.Win
-	if delta.LessThenZero() {
-		action = entities.Lost
+	groups := make([][]entities.Transaction, 0, len(currencies))
+	for _, currency := range currencies {
+		groups = append(groups, byCurrency[currency])
 	}
 
-	correctionTransaction := entities.NewTransaction(uuid.New().String(), delta, action, entities.Internal)
-	err = c.txRepo.Save(correctionTransaction)
-	if err != nil {
-		return errors.Wrap(err, "unable to save correction transaction")
+	return groups
+}
+
+// reverseTransactions cancels the given transactions of the run wallet and adds new internal transaction with inversed
+// sum of them, returns the reversal whose compensation is nil if the sum is zero. Every cancelled transaction is linked
+// to the added one, the listening workers are notified about it once the database transaction commits. The cancelled
+// transactions, the compensating transaction and the delta are recorded in the run.
+// The transactions must be in the same currency, ErrMixedCurrencies is returned otherwise. ErrTransactionNotDone is
+// returned if a transaction was reversed by another correction since it was read.
+func reverseTransactions(txRepo *repositories.TransactionRepository, run *entities.CorrectionRun, doomedTransactions []entities.Transaction) (*Reversal, error) {
+	run.Currency = doomedTransactions[0].Currency
+	delta := vo.NewAmount(0)
+	for _, tx := range doomedTransactions {
+		if tx.Currency != run.Currency {
+			return nil, ErrMixedCurrencies
+		}
+		run.TransactionIDs = append(run.TransactionIDs, tx.ID.String())
+		delta = delta.Add(tx.Amount)
+	}
+
+	reversal := &Reversal{Run: run}
+	if !delta.IsZero() {
+		delta = delta.Inverse()
+		run.Delta = delta
+		action := entities.Win
+		if delta.LessThenZero() {
+			action = entities.Lost
+		}
+
+		reversal.Compensation = entities.NewTransaction(uuid.New().String(), run.WalletID, delta, run.Currency, action, entities.Internal)
+		err := txRepo.Save(reversal.Compensation)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to save correction transaction")
+		}
+		run.CompensationID = &reversal.Compensation.ID
+
+		err = txRepo.NotifyNew(run.WalletID)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to notify about correction transaction")
+		}
+	}
+
+	for _, tx := range doomedTransactions {
+		tx.MarkAsReversed(reversal.Compensation)
+		err := txRepo.SaveReversed(&tx)
+		if errors.Is(err, repositories.ErrNotReversible) {
+			return nil, errors.Wrapf(ErrTransactionNotDone, "transaction %s was reversed concurrently", tx.ID)
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to save doomed transaction")
+		}
+		reversal.Transactions = append(reversal.Transactions, tx)
 	}
 
-	return nil
+	return reversal, nil
 }
 
-// NewCorrectionInitializer returns CorrectionProcessor instance.
-func NewCorrectionProcessor(db *gorm.DB) CorrectionProcessor {
+// NewCorrectionProcessor returns CorrectionProcessor instance.
+func NewCorrectionProcessor(db *gorm.DB, policy CorrectionPolicy) CorrectionProcessor {
 	return CorrectionProcessor{
 		txRepo:         repositories.NewTransactionRepository(db),
 		correctionRepo: repositories.NewCorrectionRepository(db),
+		runRepo:        repositories.NewCorrectionRunRepository(db),
+		policy:         policy,
 	}
 }
@@ -1,12 +1,19 @@
 package services_test
 
 import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"gorm.io/gorm"
+	"time"
 	"wallet/transaction/internal/domain/entities"
 	"wallet/transaction/internal/domain/repositories"
 	"wallet/transaction/internal/domain/services"
 	"wallet/transaction/internal/domain/vo"
+	"wallet/transaction/internal/infrastructure/db"
 )
 
 var _ = Describe("Balance Correction", func() {
@@ -15,25 +22,27 @@ var _ = Describe("Balance Correction", func() {
 		var (
 			balanceRepo     *repositories.BalanceRepository
 			transactionRepo *repositories.TransactionRepository
+			walletID        uuid.UUID
 		)
 
 		BeforeEach(func() {
 			balanceRepo = repositories.NewBalanceRepository(DB)
 			transactionRepo = repositories.NewTransactionRepository(DB)
+			walletID = uuid.New()
 
-			balance := entities.NewBalance(vo.NewTotalAmount(0))
+			balance := entities.NewBalance(walletID, vo.NewTotalAmount(0), vo.DefaultCurrency)
 			err := balanceRepo.Save(balance)
 			Expect(err).ToNot(HaveOccurred())
 		})
 		Context("no done transactions are available", func() {
 			When("correction are processed", func() {
 				BeforeEach(func() {
-					err := services.NewCorrectionProcessor(DB).Execute()
+					_, err := services.NewCorrectionProcessor(DB, services.DefaultCorrectionPolicy()).Execute(walletID)
 					Expect(err).ToNot(HaveOccurred())
 				})
 
 				It("balance remains unchanged", func() {
-					balance, err := balanceRepo.Get()
+					balance, err := balanceRepo.Get(walletID)
 					Expect(err).ToNot(HaveOccurred())
 					Expect(balance.Value.Cents).To(Equal(int64(0)))
 				})
@@ -43,19 +52,25 @@ var _ = Describe("Balance Correction", func() {
 					Expect(err).ToNot(HaveOccurred())
 					Expect(transactions).To(HaveLen(0))
 				})
+
+				It("no run is recorded", func() {
+					runs, err := repositories.NewCorrectionRunRepository(DB).FindAll()
+					Expect(err).ToNot(HaveOccurred())
+					Expect(runs).To(HaveLen(0))
+				})
 			})
 		})
 
 		Context("one transaction with positive amount exists", func() {
 			BeforeEach(func() {
-				_ = createDoneTransaction(10)
+				_ = createDoneTransaction(walletID, 10)
 			})
 
 			When("correction are processed", func() {
 				var transactions []entities.Transaction
 
 				BeforeEach(func() {
-					err := services.NewCorrectionProcessor(DB).Execute()
+					_, err := services.NewCorrectionProcessor(DB, services.DefaultCorrectionPolicy()).Execute(walletID)
 					Expect(err).ToNot(HaveOccurred())
 
 					transactions, err = transactionRepo.FindAll()
@@ -63,7 +78,7 @@ var _ = Describe("Balance Correction", func() {
 				})
 
 				It("balance remains unchanged", func() {
-					balance, err := balanceRepo.Get()
+					balance, err := balanceRepo.Get(walletID)
 					Expect(err).ToNot(HaveOccurred())
 					Expect(balance.Value.Cents).To(Equal(int64(0)))
 				})
@@ -75,20 +90,67 @@ var _ = Describe("Balance Correction", func() {
 				It("correction transaction has negative amount", func() {
 					Expect(transactions[1].Amount.Value()).To(Equal(-10))
 				})
+
+				It("cancelled transaction is linked to the correction transaction", func() {
+					Expect(transactions[0].Status).To(Equal(entities.Cancelled))
+					Expect(transactions[0].ReversedByID).ToNot(BeNil())
+					Expect(*transactions[0].ReversedByID).To(Equal(transactions[1].ID))
+					Expect(transactions[1].ReversedByID).To(BeNil())
+				})
+
+				It("run is recorded", func() {
+					runs, err := repositories.NewCorrectionRunRepository(DB).FindAll()
+					Expect(err).ToNot(HaveOccurred())
+					Expect(runs).To(HaveLen(1))
+
+					Expect(runs[0].Kind).To(Equal(entities.Automatic))
+					Expect(runs[0].WalletID).To(Equal(walletID))
+					Expect(runs[0].TransactionIDs).To(ConsistOf(transactions[0].ID.String()))
+					Expect(*runs[0].CompensationID).To(Equal(transactions[1].ID))
+					Expect(runs[0].Delta.Value()).To(Equal(-10))
+					Expect(runs[0].FinishedAt).ToNot(BeNil())
+				})
 			})
 
+			When("correction are processed while a worker listens for new transactions", func() {
+				var conn *pgx.Conn
+
+				BeforeEach(func(ctx context.Context) {
+					var err error
+					conn, err = pgx.Connect(ctx, db.NewConnection().DSN())
+					Expect(err).ToNot(HaveOccurred())
+					DeferCleanup(func() {
+						_ = conn.Close(context.Background())
+					})
+
+					_, err = conn.Exec(ctx, "LISTEN "+repositories.NewTransactionsChannel)
+					Expect(err).ToNot(HaveOccurred())
+
+					_, err = services.NewCorrectionProcessor(DB, services.DefaultCorrectionPolicy()).Execute(walletID)
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				It("worker is notified about the correction transaction", func(ctx context.Context) {
+					ctx, cancel := context.WithTimeout(ctx, time.Second)
+					defer cancel()
+
+					notification, err := conn.WaitForNotification(ctx)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(notification.Payload).To(Equal(walletID.String()))
+				})
+			})
 		})
 
 		Context("one transaction with negative amount exists", func() {
 			var transactions []entities.Transaction
 
 			BeforeEach(func() {
-				_ = createDoneTransaction(-10)
+				_ = createDoneTransaction(walletID, -10)
 			})
 
 			When("correction are processed", func() {
 				BeforeEach(func() {
-					err := services.NewCorrectionProcessor(DB).Execute()
+					_, err := services.NewCorrectionProcessor(DB, services.DefaultCorrectionPolicy()).Execute(walletID)
 					Expect(err).ToNot(HaveOccurred())
 
 					transactions, err = transactionRepo.FindAll()
@@ -96,7 +158,7 @@ var _ = Describe("Balance Correction", func() {
 				})
 
 				It("balance remains unchanged", func() {
-					balance, err := balanceRepo.Get()
+					balance, err := balanceRepo.Get(walletID)
 					Expect(err).ToNot(HaveOccurred())
 					Expect(balance.Value.Cents).To(Equal(int64(0)))
 				})
@@ -112,18 +174,37 @@ var _ = Describe("Balance Correction", func() {
 
 		})
 
+		Context("a transaction of another wallet exists", func() {
+			BeforeEach(func() {
+				_ = createDoneTransaction(uuid.New(), 10)
+			})
+
+			When("correction are processed", func() {
+				BeforeEach(func() {
+					_, err := services.NewCorrectionProcessor(DB, services.DefaultCorrectionPolicy()).Execute(walletID)
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				It("no new transactions are added", func() {
+					transactions, err := transactionRepo.FindAll()
+					Expect(err).ToNot(HaveOccurred())
+					Expect(transactions).To(HaveLen(1))
+				})
+			})
+		})
+
 		Context("three transactions are exists", func() {
 			var transactions []entities.Transaction
 
 			BeforeEach(func() {
-				_ = createDoneTransaction(-10)
-				_ = createDoneTransaction(-1)
-				_ = createDoneTransaction(-100)
+				_ = createDoneTransaction(walletID, -10)
+				_ = createDoneTransaction(walletID, -1)
+				_ = createDoneTransaction(walletID, -100)
 			})
 
 			When("correction are processed", func() {
 				BeforeEach(func() {
-					err := services.NewCorrectionProcessor(DB).Execute()
+					_, err := services.NewCorrectionProcessor(DB, services.DefaultCorrectionPolicy()).Execute(walletID)
 					Expect(err).ToNot(HaveOccurred())
 
 					transactions, err = transactionRepo.FindAll()
@@ -139,5 +220,187 @@ var _ = Describe("Balance Correction", func() {
 				})
 			})
 		})
+
+		Context("transactions in different currencies exist", func() {
+			var (
+				euroTransaction   *entities.Transaction
+				dollarTransaction *entities.Transaction
+			)
+
+			BeforeEach(func() {
+				euroTransaction = createDoneTransaction(walletID, 10)
+				dollarTransaction = entities.NewTransaction(uuid.New().String(), walletID, vo.NewAmount(20), vo.Currency("USD"), entities.Win, entities.Game)
+				dollarTransaction.Status = entities.Done
+				Expect(transactionRepo.Save(dollarTransaction)).To(Succeed())
+			})
+
+			When("correction are processed", func() {
+				var runs []entities.CorrectionRun
+
+				BeforeEach(func() {
+					policy := services.DefaultCorrectionPolicy()
+					policy.Strategy = services.IDsStrategy
+					policy.TransactionIDs = []uuid.UUID{euroTransaction.ID, dollarTransaction.ID}
+
+					_, err := services.NewCorrectionProcessor(DB, policy).Execute(walletID)
+					Expect(err).ToNot(HaveOccurred())
+
+					runs, err = repositories.NewCorrectionRunRepository(DB).FindAll()
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				It("every currency is compensated by its own transaction", func() {
+					Expect(runs).To(HaveLen(2))
+					for _, run := range runs {
+						compensation, err := transactionRepo.FindByID(*run.CompensationID)
+						Expect(err).ToNot(HaveOccurred())
+						Expect(compensation.Currency).To(Equal(run.Currency))
+						Expect(compensation.Amount.Cents).To(Equal(run.Delta.Cents))
+					}
+					Expect(runs).To(ContainElement(SatisfyAll(
+						HaveField("Currency", vo.DefaultCurrency),
+						HaveField("TransactionIDs", ConsistOf(euroTransaction.ID.String())),
+					)))
+					Expect(runs).To(ContainElement(SatisfyAll(
+						HaveField("Currency", vo.Currency("USD")),
+						HaveField("TransactionIDs", ConsistOf(dollarTransaction.ID.String())),
+					)))
+				})
+			})
+		})
+
+		Context("transactions of different source types exist", func() {
+			var (
+				gameTransaction    *entities.Transaction
+				paymentTransaction *entities.Transaction
+			)
+
+			BeforeEach(func() {
+				gameTransaction = createDoneTransaction(walletID, 10)
+				paymentTransaction = createTransactionWithSourceType(walletID, 20, entities.Done, entities.Payment)
+			})
+
+			When("correction are processed with the source type strategy", func() {
+				BeforeEach(func() {
+					policy := services.DefaultCorrectionPolicy()
+					policy.Strategy = services.SourceTypeStrategy
+					policy.SourceType = entities.Payment
+
+					_, err := services.NewCorrectionProcessor(DB, policy).Execute(walletID)
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				It("only transactions of the source type are cancelled", func() {
+					transaction, err := transactionRepo.FindByID(paymentTransaction.ID)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(transaction.Status).To(Equal(entities.Cancelled))
+
+					transaction, err = transactionRepo.FindByID(gameTransaction.ID)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(transaction.Status).To(Equal(entities.Done))
+				})
+			})
+
+			When("correction are processed with the IDs strategy", func() {
+				BeforeEach(func() {
+					policy := services.DefaultCorrectionPolicy()
+					policy.Strategy = services.IDsStrategy
+					policy.TransactionIDs = []uuid.UUID{gameTransaction.ID}
+
+					_, err := services.NewCorrectionProcessor(DB, policy).Execute(walletID)
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				It("only transactions with the IDs are cancelled", func() {
+					transaction, err := transactionRepo.FindByID(gameTransaction.ID)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(transaction.Status).To(Equal(entities.Cancelled))
+
+					transaction, err = transactionRepo.FindByID(paymentTransaction.ID)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(transaction.Status).To(Equal(entities.Done))
+				})
+			})
+		})
+
+		Context("a transaction is corrected manually and automatically at the same time", func() {
+			var (
+				transaction *entities.Transaction
+				policy      services.CorrectionPolicy
+			)
+
+			BeforeEach(func() {
+				transaction = createDoneTransaction(walletID, 10)
+
+				policy = services.DefaultCorrectionPolicy()
+				policy.Strategy = services.IDsStrategy
+				policy.TransactionIDs = []uuid.UUID{transaction.ID}
+			})
+
+			compensations := func() []entities.Transaction {
+				GinkgoHelper()
+
+				transactions, err := transactionRepo.List(repositories.TransactionFilter{
+					WalletID:   &walletID,
+					SourceType: entities.Internal,
+					Limit:      10,
+				})
+				Expect(err).ToNot(HaveOccurred())
+
+				return transactions
+			}
+
+			When("the manual correction holds the transaction", func() {
+				It("automatic correction skips it and the transaction is compensated once", func() {
+					tx := DB.Begin()
+					DeferCleanup(func() {
+						tx.Rollback()
+					})
+
+					_, _, err := services.NewManualCorrector(tx).Execute([]uuid.UUID{transaction.ID}, "duplicate payout", "support")
+					Expect(err).ToNot(HaveOccurred())
+
+					var reversals []services.Reversal
+					err = DB.Transaction(func(tx *gorm.DB) error {
+						reversals, err = services.NewCorrectionProcessor(tx, policy).Execute(walletID)
+						return err
+					})
+					Expect(err).ToNot(HaveOccurred())
+					Expect(reversals).To(BeEmpty())
+
+					Expect(tx.Commit().Error).ToNot(HaveOccurred())
+					Expect(compensations()).To(HaveLen(1))
+				})
+			})
+
+			When("the automatic correction holds the transaction", func() {
+				It("manual correction fails once it is released and the transaction is compensated once", func() {
+					tx := DB.Begin()
+					DeferCleanup(func() {
+						tx.Rollback()
+					})
+
+					reversals, err := services.NewCorrectionProcessor(tx, policy).Execute(walletID)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(reversals).To(HaveLen(1))
+
+					manualErr := make(chan error, 1)
+					go func() {
+						defer GinkgoRecover()
+						manualErr <- DB.Transaction(func(tx *gorm.DB) error {
+							_, _, err := services.NewManualCorrector(tx).Execute([]uuid.UUID{transaction.ID}, "duplicate payout", "support")
+							return err
+						})
+					}()
+					Consistently(manualErr, 200*time.Millisecond).ShouldNot(Receive())
+
+					Expect(tx.Commit().Error).ToNot(HaveOccurred())
+
+					Eventually(manualErr, time.Second).Should(Receive(&err))
+					Expect(errors.Is(err, services.ErrTransactionNotDone)).To(BeTrue())
+					Expect(compensations()).To(HaveLen(1))
+				})
+			})
+		})
 	})
 })
@@ -8,13 +8,14 @@ import (
 	"wallet/transaction/internal/domain/repositories"
 )
 
-// CorrectionProvider checks at correction are exist otherwise creating it
+// CorrectionProvider checks at correction of the wallet are exist otherwise creating it
 type CorrectionProvider struct {
 	correctionRepo *repositories.CorrectionRepository
 }
 
-func (c CorrectionProvider) Provide() (*entities.Correction, error) {
-	correction, err := c.correctionRepo.Get()
+// Provide returns the correction of the given wallet, creating it if it does not exist yet.
+func (c CorrectionProvider) Provide(walletID uuid.UUID) (*entities.Correction, error) {
+	correction, err := c.correctionRepo.Get(walletID)
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot provide correction")
 	}
@@ -22,7 +23,7 @@ func (c CorrectionProvider) Provide() (*entities.Correction, error) {
 	if correction != nil {
 		return correction, nil
 	}
-	correction = entities.NewCorrection(uuid.MustParse(entities.CorrectionId))
+	correction = entities.NewCorrection(walletID)
 
 	err = c.correctionRepo.Save(correction)
 	if err != nil {
@@ -32,6 +33,23 @@ func (c CorrectionProvider) Provide() (*entities.Correction, error) {
 	return correction, nil
 }
 
+// ProvideMissing creates corrections for every wallet which has a balance but no correction yet.
+func (c CorrectionProvider) ProvideMissing() error {
+	walletIDs, err := c.correctionRepo.FindUnscheduledWallets()
+	if err != nil {
+		return errors.Wrap(err, "cannot find unscheduled wallets")
+	}
+
+	for _, walletID := range walletIDs {
+		err = c.correctionRepo.Create(entities.NewCorrection(walletID))
+		if err != nil {
+			return errors.Wrap(err, "cannot save correction")
+		}
+	}
+
+	return nil
+}
+
 // NewCorrectionProvider returns new CorrectionProvider instance
 func NewCorrectionProvider(db *gorm.DB) CorrectionProvider {
 	return CorrectionProvider{
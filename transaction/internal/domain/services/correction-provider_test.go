@@ -7,14 +7,19 @@ import (
 	"wallet/transaction/internal/domain/entities"
 	"wallet/transaction/internal/domain/repositories"
 	"wallet/transaction/internal/domain/services"
+	"wallet/transaction/internal/domain/vo"
 )
 
 var _ = Describe("correction record lazy creation", func() {
 	Context("correction record are not exist", func() {
-		var correctionProvider services.CorrectionProvider
+		var (
+			correctionProvider services.CorrectionProvider
+			walletID           uuid.UUID
+		)
 
 		BeforeEach(func() {
 			correctionProvider = services.NewCorrectionProvider(DB)
+			walletID = uuid.New()
 		})
 
 		When("trying to provide transaction", func() {
@@ -25,7 +30,7 @@ var _ = Describe("correction record lazy creation", func() {
 			)
 			BeforeEach(func() {
 				correctionRepository = repositories.NewCorrectionRepository(DB)
-				correction, err = correctionProvider.Provide()
+				correction, err = correctionProvider.Provide(walletID)
 				Expect(err).ToNot(HaveOccurred())
 				Expect(correction).ToNot(BeNil())
 			})
@@ -41,10 +46,12 @@ var _ = Describe("correction record lazy creation", func() {
 	Context("correction record are exist", func() {
 		var (
 			correctionProvider services.CorrectionProvider
+			walletID           uuid.UUID
 		)
 
 		BeforeEach(func() {
 			correctionProvider = services.NewCorrectionProvider(DB)
+			walletID = uuid.New()
 		})
 
 		When("trying to provide transaction", func() {
@@ -57,11 +64,11 @@ var _ = Describe("correction record lazy creation", func() {
 			BeforeEach(func() {
 				correctionRepository = repositories.NewCorrectionRepository(DB)
 
-				correction = entities.NewCorrection(uuid.MustParse(entities.CorrectionId))
+				correction = entities.NewCorrection(walletID)
 				err = correctionRepository.Save(correction)
 				Expect(err).ToNot(HaveOccurred())
 
-				correction, err = correctionProvider.Provide()
+				correction, err = correctionProvider.Provide(walletID)
 				Expect(err).ToNot(HaveOccurred())
 				Expect(correction).ToNot(BeNil())
 			})
@@ -74,4 +81,39 @@ var _ = Describe("correction record lazy creation", func() {
 		})
 	})
 
+	Context("wallets with balance but without correction are exist", func() {
+		var (
+			correctionProvider   services.CorrectionProvider
+			correctionRepository *repositories.CorrectionRepository
+			scheduledWalletID    uuid.UUID
+		)
+
+		BeforeEach(func() {
+			correctionProvider = services.NewCorrectionProvider(DB)
+			correctionRepository = repositories.NewCorrectionRepository(DB)
+			balanceRepository := repositories.NewBalanceRepository(DB)
+
+			scheduledWalletID = uuid.New()
+			err := balanceRepository.Save(entities.NewBalance(scheduledWalletID, vo.NewTotalAmount(0), vo.DefaultCurrency))
+			Expect(err).ToNot(HaveOccurred())
+			err = correctionRepository.Save(entities.NewCorrection(scheduledWalletID))
+			Expect(err).ToNot(HaveOccurred())
+
+			err = balanceRepository.Save(entities.NewBalance(uuid.New(), vo.NewTotalAmount(0), vo.DefaultCurrency))
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		When("trying to provide missing corrections", func() {
+			BeforeEach(func() {
+				err := correctionProvider.ProvideMissing()
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("correction should be created for each wallet", func() {
+				corrections, err := correctionRepository.FindAll()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(corrections).To(HaveLen(2))
+			})
+		})
+	})
 })
@@ -9,22 +9,27 @@ import (
 	"wallet/transaction/internal/domain/vo"
 )
 
-func createTransaction(amount int) *entities.Transaction {
+func createTransaction(walletID uuid.UUID, amount int) *entities.Transaction {
 	GinkgoHelper()
-	return createTransactionWithStatus(amount, entities.New)
+	return createTransactionWithStatus(walletID, amount, entities.New)
 }
 
-func createCancelledTransaction(amount int) *entities.Transaction {
+func createCancelledTransaction(walletID uuid.UUID, amount int) *entities.Transaction {
 	GinkgoHelper()
-	return createTransactionWithStatus(amount, entities.Cancelled)
+	return createTransactionWithStatus(walletID, amount, entities.Cancelled)
 }
 
-func createDoneTransaction(amount int) *entities.Transaction {
+func createDoneTransaction(walletID uuid.UUID, amount int) *entities.Transaction {
 	GinkgoHelper()
-	return createTransactionWithStatus(amount, entities.Done)
+	return createTransactionWithStatus(walletID, amount, entities.Done)
 }
 
-func createTransactionWithStatus(amount int, status string) *entities.Transaction {
+func createTransactionWithStatus(walletID uuid.UUID, amount int, status string) *entities.Transaction {
+	GinkgoHelper()
+	return createTransactionWithSourceType(walletID, amount, status, entities.Game)
+}
+
+func createTransactionWithSourceType(walletID uuid.UUID, amount int, status string, sourceType string) *entities.Transaction {
 	GinkgoHelper()
 
 	action := entities.Win
@@ -33,7 +38,7 @@ func createTransactionWithStatus(amount int, status string) *entities.Transactio
 	}
 
 	transactionRepo := repositories.NewTransactionRepository(DB)
-	transaction := entities.NewTransaction(uuid.New().String(), vo.NewAmount(amount), action, entities.Game)
+	transaction := entities.NewTransaction(uuid.New().String(), walletID, vo.NewAmount(amount), vo.DefaultCurrency, action, sourceType)
 	transaction.Status = status
 
 	err := transactionRepo.Save(transaction)
@@ -0,0 +1,103 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+	"time"
+	"wallet/transaction/internal/domain/entities"
+	"wallet/transaction/internal/domain/repositories"
+	"wallet/transaction/internal/domain/vo"
+)
+
+// ErrUnbalancedPostings is returned when the debits of the postings do not equal the credits.
+var ErrUnbalancedPostings = errors.New("postings are not balanced")
+
+// ErrLedgerMismatch is returned when the stored balance differs from the balance derived from the ledger.
+var ErrLedgerMismatch = errors.New("balance differs from the ledger")
+
+// LedgerStorage ledger postings storage.
+type LedgerStorage interface {
+	Create(postings []entities.Posting) error
+	AccountBalance(walletID uuid.UUID, account string, at *time.Time) (int64, error)
+}
+
+// Ledger records the postings of the processed transactions and derives the wallet balances from them.
+type Ledger struct {
+	repo        LedgerStorage
+	balanceRepo BalanceRepository
+}
+
+// Record appends the balanced postings of the given transaction to the ledger.
+func (l Ledger) Record(transaction *entities.Transaction) error {
+	postings := entities.NewPostings(transaction)
+
+	var total int64
+	for _, posting := range postings {
+		total += posting.Signed()
+	}
+	if total != 0 {
+		return fmt.Errorf("%w: transaction %s", ErrUnbalancedPostings, transaction.ID)
+	}
+
+	return errors.Wrap(l.repo.Create(postings), "cannot record postings")
+}
+
+// Balance returns the balance of the wallet derived from the ledger at the given time, the current balance
+// is returned if the time is not set.
+func (l Ledger) Balance(walletID uuid.UUID, at *time.Time) (vo.TotalAmount, error) {
+	value, err := l.repo.AccountBalance(walletID, entities.WalletAccount, at)
+	if err != nil {
+		return vo.TotalAmount{}, errors.Wrap(err, "cannot derive balance")
+	}
+
+	return vo.NewTotalAmount(value), nil
+}
+
+// Verify checks that the stored balance of the wallet equals the balance derived from the ledger.
+func (l Ledger) Verify(walletID uuid.UUID) error {
+	balance, err := l.balanceRepo.Get(walletID)
+	if err != nil {
+		return errors.Wrap(err, "cannot verify balance")
+	}
+
+	stored := vo.NewTotalAmount(0)
+	if balance != nil {
+		stored = balance.Value
+	}
+
+	derived, err := l.Balance(walletID, nil)
+	if err != nil {
+		return err
+	}
+
+	if stored.Cents != derived.Cents {
+		return fmt.Errorf("%w: wallet %s has %d, ledger has %d", ErrLedgerMismatch, walletID, stored.Cents, derived.Cents)
+	}
+
+	return nil
+}
+
+// WithContext returns a copy of the ledger running its queries with the given context, injected storages which cannot
+// run with a context are kept as is.
+func (l Ledger) WithContext(ctx context.Context) Ledger {
+	ledger := Ledger{repo: l.repo, balanceRepo: l.balanceRepo}
+	if repo, ok := l.repo.(*repositories.PostingRepository); ok {
+		ledger.repo = repo.WithContext(ctx)
+	}
+	if balanceRepo, ok := l.balanceRepo.(*repositories.BalanceRepository); ok {
+		ledger.balanceRepo = balanceRepo.WithContext(ctx)
+	}
+
+	return ledger
+}
+
+// NewLedger returns Ledger instance.
+func NewLedger(db *gorm.DB) Ledger {
+	return Ledger{
+		repo:        repositories.NewPostingRepository(db),
+		balanceRepo: repositories.NewBalanceRepository(db),
+	}
+}
@@ -0,0 +1,112 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"time"
+	"wallet/transaction/internal/domain/entities"
+	"wallet/transaction/internal/domain/repositories"
+	"wallet/transaction/internal/domain/services"
+	"wallet/transaction/internal/domain/vo"
+)
+
+var _ = Describe("Ledger", func() {
+	var (
+		transactionProcessor services.TransactionProcessor
+		postingRepo          *repositories.PostingRepository
+		ledger               services.Ledger
+		walletID             uuid.UUID
+	)
+
+	BeforeEach(func() {
+		walletID = uuid.New()
+		transactionProcessor = services.NewTransactionProcessor(DB)
+		postingRepo = repositories.NewPostingRepository(DB)
+		ledger = services.NewLedger(DB)
+	})
+
+	When("a win and a loss are processed", func() {
+		var win, loss *entities.Transaction
+
+		BeforeEach(func() {
+			win = createTransaction(walletID, 100)
+			Expect(transactionProcessor.Execute(context.Background(), win)).To(Succeed())
+
+			loss = createTransaction(walletID, -30)
+			Expect(transactionProcessor.Execute(context.Background(), loss)).To(Succeed())
+		})
+
+		It("every transaction should produce a balanced pair of postings", func() {
+			for _, tx := range []*entities.Transaction{win, loss} {
+				postings, err := postingRepo.FindByTransactionID(tx.ID)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(postings).To(HaveLen(2))
+				Expect(postings[0].Signed() + postings[1].Signed()).To(BeZero())
+			}
+		})
+
+		It("the win should credit the wallet account", func() {
+			postings, err := postingRepo.FindByTransactionID(win.ID)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(postings).To(ContainElement(SatisfyAll(
+				HaveField("Account", entities.WalletAccount),
+				HaveField("Direction", entities.Credit),
+				HaveField("Amount.Cents", int64(100)),
+			)))
+			Expect(postings).To(ContainElement(SatisfyAll(
+				HaveField("Account", entities.Game),
+				HaveField("Direction", entities.Debit),
+			)))
+		})
+
+		It("the balance derived from the ledger should equal the stored balance", func() {
+			balance, err := ledger.Balance(walletID, nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(balance.Cents).To(Equal(int64(70)))
+
+			Expect(ledger.Verify(walletID)).To(Succeed())
+		})
+
+		It("the balance before the postings should be zero", func() {
+			before := time.Now().Add(-time.Hour)
+			balance, err := ledger.Balance(walletID, &before)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(balance.Cents).To(BeZero())
+		})
+	})
+
+	When("a transaction is cancelled", func() {
+		var transaction *entities.Transaction
+
+		BeforeEach(func() {
+			transaction = createTransaction(walletID, -10)
+			Expect(transactionProcessor.Execute(context.Background(), transaction)).To(Succeed())
+		})
+
+		It("no postings should be recorded", func() {
+			postings, err := postingRepo.FindByTransactionID(transaction.ID)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(postings).To(BeEmpty())
+		})
+	})
+
+	When("the stored balance diverges from the ledger", func() {
+		BeforeEach(func() {
+			Expect(transactionProcessor.Execute(context.Background(), createTransaction(walletID, 100))).To(Succeed())
+
+			balanceRepo := repositories.NewBalanceRepository(DB)
+			balance, err := balanceRepo.Get(walletID)
+			Expect(err).ToNot(HaveOccurred())
+			balance.Value = vo.NewTotalAmount(150)
+			Expect(balanceRepo.Save(balance)).To(Succeed())
+		})
+
+		It("verification should fail", func() {
+			err := ledger.Verify(walletID)
+			Expect(errors.Is(err, services.ErrLedgerMismatch)).To(BeTrue())
+		})
+	})
+})
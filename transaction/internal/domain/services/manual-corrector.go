@@ -0,0 +1,101 @@
+package services
+
+import (
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+	"wallet/transaction/internal/domain/entities"
+	"wallet/transaction/internal/domain/repositories"
+	"wallet/transaction/internal/domain/vo"
+)
+
+// ErrTransactionNotFound error.
+var ErrTransactionNotFound = errors.New("transaction not found")
+
+// ErrWalletMismatch error.
+var ErrWalletMismatch = errors.New("transactions belong to different wallets")
+
+// ErrTransactionNotDone error.
+var ErrTransactionNotDone = errors.New("transaction is not done")
+
+// ErrMixedCurrencies error.
+var ErrMixedCurrencies = errors.New("transactions are in different currencies")
+
+// ManualCorrector cancels the transactions chosen by a support agent and posts a single compensating transaction.
+type ManualCorrector struct {
+	txRepo         *repositories.TransactionRepository
+	correctionRepo *repositories.ManualCorrectionRepository
+	runRepo        *repositories.CorrectionRunRepository
+}
+
+// Execute cancels the given done transactions of a single wallet in a single currency, adds new internal transaction
+// with inversed sum of them and records who requested the correction and why along with the correction run.
+// Returns the correction and its reversal.
+func (m ManualCorrector) Execute(ids []uuid.UUID, reason string, requestedBy string) (*entities.ManualCorrection, *Reversal, error) {
+	transactions, err := m.txRepo.LockByIDs(ids)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "cannot lock transactions")
+	}
+
+	if len(transactions) == 0 || len(transactions) != countUnique(ids) {
+		return nil, nil, ErrTransactionNotFound
+	}
+
+	walletID := transactions[0].WalletID
+	currency := transactions[0].Currency
+	transactionIDs := make(vo.TransactionIds, len(transactions))
+	for i, tx := range transactions {
+		if tx.WalletID != walletID {
+			return nil, nil, ErrWalletMismatch
+		}
+		if tx.Currency != currency {
+			return nil, nil, ErrMixedCurrencies
+		}
+		if tx.Status != entities.Done {
+			return nil, nil, errors.Wrapf(ErrTransactionNotDone, "transaction %s is %s", tx.ID, tx.Status)
+		}
+		transactionIDs[i] = tx.ID.String()
+	}
+
+	run := entities.NewCorrectionRun(entities.Manual, walletID)
+	reversal, err := reverseTransactions(m.txRepo, run, transactions)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	run.Finish()
+	err = m.runRepo.Save(run)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "cannot save correction run")
+	}
+
+	correction := entities.NewManualCorrection(walletID, transactionIDs, reason, requestedBy)
+	if reversal.Compensation != nil {
+		correction.CompensationID = &reversal.Compensation.ID
+	}
+
+	err = m.correctionRepo.Save(correction)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "cannot save manual correction")
+	}
+
+	return correction, reversal, nil
+}
+
+func countUnique(ids []uuid.UUID) int {
+	unique := make(map[uuid.UUID]struct{}, len(ids))
+	for _, id := range ids {
+		unique[id] = struct{}{}
+	}
+
+	return len(unique)
+}
+
+// NewManualCorrector returns ManualCorrector instance.
+func NewManualCorrector(db *gorm.DB) ManualCorrector {
+	return ManualCorrector{
+		txRepo:         repositories.NewTransactionRepository(db),
+		correctionRepo: repositories.NewManualCorrectionRepository(db),
+		runRepo:        repositories.NewCorrectionRunRepository(db),
+	}
+}
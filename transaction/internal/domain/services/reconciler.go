@@ -0,0 +1,94 @@
+package services
+
+import (
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+	"wallet/transaction/internal/domain/entities"
+	"wallet/transaction/internal/domain/repositories"
+	"wallet/transaction/internal/domain/vo"
+)
+
+// ReconciledBalanceStorage balance storage locking the balance while it is reconciled.
+type ReconciledBalanceStorage interface {
+	GetForUpdate(walletID uuid.UUID) (*entities.Balance, error)
+	Save(balance *entities.Balance) error
+}
+
+// DiscrepancySaver saves balance discrepancies.
+type DiscrepancySaver interface {
+	Save(discrepancy *entities.BalanceDiscrepancy) error
+}
+
+// LedgerVerifier verifies the stored balances against the ledger.
+type LedgerVerifier interface {
+	Verify(walletID uuid.UUID) error
+}
+
+// Reconciler compares the stored balance of a wallet with the sum of its done transactions and verifies it against
+// the ledger.
+type Reconciler struct {
+	balanceRepo     ReconciledBalanceStorage
+	calculator      BalanceCalculator
+	discrepancyRepo DiscrepancySaver
+	ledger          LedgerVerifier
+	autoRepair      bool
+}
+
+// Execute reconciles the balance of the given wallet, records a discrepancy if the stored balance differs from the sum
+// of the done transactions and, if the auto repair is enabled, overwrites the stored balance with the sum. Returns the
+// recorded discrepancy or nil if the balance is consistent. A consistent or repaired balance is verified against the
+// ledger, ErrLedgerMismatch is returned if it differs, so the repair must be rolled back. Must be called within
+// a database transaction, so the balance stays locked until the transactions are summed up.
+func (r Reconciler) Execute(walletID uuid.UUID) (*entities.BalanceDiscrepancy, error) {
+	balance, err := r.balanceRepo.GetForUpdate(walletID)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot reconcile balance")
+	}
+	if balance == nil {
+		return nil, nil
+	}
+
+	calculatedValue, err := r.calculator.CalculateBalance(walletID)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot reconcile balance")
+	}
+
+	calculated := vo.NewTotalAmount(calculatedValue)
+	if calculated.Cents == balance.Value.Cents {
+		return nil, r.ledger.Verify(walletID)
+	}
+
+	discrepancy := entities.NewBalanceDiscrepancy(balance, calculated)
+	if r.autoRepair {
+		balance.Value = calculated
+		err = r.balanceRepo.Save(balance)
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot repair balance")
+		}
+		discrepancy.Repaired = true
+
+		err = r.ledger.Verify(walletID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = r.discrepancyRepo.Save(discrepancy)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot save discrepancy")
+	}
+
+	return discrepancy, nil
+}
+
+// NewReconciler returns Reconciler instance, the stored balances are overwritten on discrepancies if autoRepair is set.
+func NewReconciler(db *gorm.DB, autoRepair bool) Reconciler {
+	return Reconciler{
+		balanceRepo:     repositories.NewBalanceRepository(db),
+		calculator:      repositories.NewTransactionRepository(db),
+		discrepancyRepo: repositories.NewBalanceDiscrepancyRepository(db),
+		ledger:          NewLedger(db),
+		autoRepair:      autoRepair,
+	}
+}
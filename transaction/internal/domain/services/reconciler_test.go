@@ -0,0 +1,136 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"wallet/transaction/internal/domain/entities"
+	"wallet/transaction/internal/domain/repositories"
+	"wallet/transaction/internal/domain/services"
+	"wallet/transaction/internal/domain/vo"
+)
+
+var _ = Describe("Balance reconciliation", func() {
+	var (
+		balanceRepo     *repositories.BalanceRepository
+		discrepancyRepo *repositories.BalanceDiscrepancyRepository
+		walletID        uuid.UUID
+	)
+
+	BeforeEach(func() {
+		walletID = uuid.New()
+		balanceRepo = repositories.NewBalanceRepository(DB)
+		discrepancyRepo = repositories.NewBalanceDiscrepancyRepository(DB)
+
+		Expect(services.NewLedger(DB).Record(createDoneTransaction(walletID, 100))).To(Succeed())
+	})
+
+	Context("the stored balance equals the sum of the transactions", func() {
+		BeforeEach(func() {
+			Expect(balanceRepo.Save(entities.NewBalance(walletID, vo.NewTotalAmount(100), vo.DefaultCurrency))).To(Succeed())
+		})
+
+		It("no discrepancy is recorded", func() {
+			discrepancy, err := services.NewReconciler(DB, false).Execute(walletID)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(discrepancy).To(BeNil())
+
+			discrepancies, err := discrepancyRepo.FindByWalletID(walletID)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(discrepancies).To(BeEmpty())
+		})
+	})
+
+	Context("the stored balance differs from the sum of the transactions", func() {
+		BeforeEach(func() {
+			Expect(balanceRepo.Save(entities.NewBalance(walletID, vo.NewTotalAmount(150), vo.DefaultCurrency))).To(Succeed())
+		})
+
+		When("the balance is reconciled without repair", func() {
+			var discrepancy *entities.BalanceDiscrepancy
+
+			BeforeEach(func() {
+				var err error
+				discrepancy, err = services.NewReconciler(DB, false).Execute(walletID)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("discrepancy is recorded", func() {
+				Expect(discrepancy).ToNot(BeNil())
+				Expect(discrepancy.Drift()).To(Equal(int64(50)))
+				Expect(discrepancy.Repaired).To(BeFalse())
+
+				discrepancies, err := discrepancyRepo.FindByWalletID(walletID)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(discrepancies).To(HaveLen(1))
+			})
+
+			It("balance remains unchanged", func() {
+				balance, err := balanceRepo.Get(walletID)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(balance.Value.Cents).To(Equal(int64(150)))
+			})
+		})
+
+		When("the balance is reconciled with repair", func() {
+			var discrepancy *entities.BalanceDiscrepancy
+
+			BeforeEach(func() {
+				var err error
+				discrepancy, err = services.NewReconciler(DB, true).Execute(walletID)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("balance is repaired", func() {
+				Expect(discrepancy.Repaired).To(BeTrue())
+
+				balance, err := balanceRepo.Get(walletID)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(balance.Value.Cents).To(Equal(int64(100)))
+			})
+		})
+	})
+
+	Context("a done transaction is missing from the ledger", func() {
+		BeforeEach(func() {
+			_ = createDoneTransaction(walletID, 20)
+		})
+
+		It("reconciliation of the consistent balance should report the ledger mismatch", func() {
+			Expect(balanceRepo.Save(entities.NewBalance(walletID, vo.NewTotalAmount(120), vo.DefaultCurrency))).To(Succeed())
+
+			_, err := services.NewReconciler(DB, false).Execute(walletID)
+			Expect(errors.Is(err, services.ErrLedgerMismatch)).To(BeTrue())
+		})
+
+		It("repair of the balance should report the ledger mismatch", func() {
+			Expect(balanceRepo.Save(entities.NewBalance(walletID, vo.NewTotalAmount(150), vo.DefaultCurrency))).To(Succeed())
+
+			_, err := services.NewReconciler(DB, true).Execute(walletID)
+			Expect(errors.Is(err, services.ErrLedgerMismatch)).To(BeTrue())
+		})
+	})
+
+	Context("a correction reversed the transaction", func() {
+		BeforeEach(func() {
+			Expect(balanceRepo.Save(entities.NewBalance(walletID, vo.NewTotalAmount(100), vo.DefaultCurrency))).To(Succeed())
+			reversals, err := services.NewCorrectionProcessor(DB, services.DefaultCorrectionPolicy()).Execute(walletID)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(reversals).To(HaveLen(1))
+
+			Expect(services.NewTransactionProcessor(DB).Execute(context.Background(), reversals[0].Compensation)).To(Succeed())
+		})
+
+		It("no discrepancy is recorded and the balance remains unchanged", func() {
+			discrepancy, err := services.NewReconciler(DB, true).Execute(walletID)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(discrepancy).To(BeNil())
+
+			balance, err := balanceRepo.Get(walletID)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(balance.Value.Cents).To(BeZero())
+		})
+	})
+})
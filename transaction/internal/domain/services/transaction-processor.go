@@ -1,44 +1,70 @@
 package services
 
 import (
+	"context"
 	"errors"
 	"gorm.io/gorm"
 	"wallet/transaction/internal/domain/entities"
 	"wallet/transaction/internal/domain/repositories"
 )
 
+// maxBalanceUpdateAttempts limits how many times the balance update is retried on concurrent modification.
+const maxBalanceUpdateAttempts = 5
+
 // TransactionProcessor handles the processing of transactions,
-// including repository operations and balance aggregation.
+// including repository operations, balance aggregation and ledger postings.
 type TransactionProcessor struct {
 	TxRepo         *repositories.TransactionRepository
 	BalanceService *Balance
+	Ledger         Ledger
 }
 
 // Execute processes the given transaction by updating the balance and marking the transaction as done
-// or cancelled based on the outcome. Internal transactions ignoring negative balance validation
-func (t TransactionProcessor) Execute(transaction *entities.Transaction) error {
-	var err error
+// or cancelled based on the outcome, transactions in a currency other than the wallet currency are cancelled.
+// The reason of the cancellation is kept on the transaction.
+// Internal transactions ignoring negative balance validation. Done transactions are recorded in the ledger.
+// The queries run with the given context, so they are traced within the span of the processing.
+func (t TransactionProcessor) Execute(ctx context.Context, transaction *entities.Transaction) error {
+	t.TxRepo = t.TxRepo.WithContext(ctx)
+	t.BalanceService = t.BalanceService.WithContext(ctx)
+	t.Ledger = t.Ledger.WithContext(ctx)
 
-	if transaction.IsInternal() {
-		err = t.BalanceService.ForceUpdateBalance(transaction.Amount)
-	} else {
-		err = t.BalanceService.UpdateBalance(transaction.Amount)
-	}
-
-	if err != nil && errors.Is(err, ErrNegativeBalance) {
-		transaction.MarkAsCancelled()
-	} else if err != nil {
+	err := t.updateBalance(transaction)
+	switch {
+	case errors.Is(err, ErrNegativeBalance):
+		transaction.MarkAsCancelled(entities.InsufficientFunds)
+	case errors.Is(err, ErrCurrencyMismatch):
+		transaction.MarkAsCancelled(entities.CurrencyMismatch)
+	case err != nil:
 		return err
-	} else {
+	default:
 		transaction.MarkAsDone()
+		err = t.Ledger.Record(transaction)
+		if err != nil {
+			return err
+		}
 	}
 
-	err = t.TxRepo.Save(transaction)
-	if err != nil {
-		return err
+	return t.TxRepo.Save(transaction)
+}
+
+// updateBalance applies the transaction amount to the wallet balance, the update is retried with the fresh balance
+// if the balance was modified concurrently.
+func (t TransactionProcessor) updateBalance(transaction *entities.Transaction) error {
+	var err error
+	for attempt := 0; attempt < maxBalanceUpdateAttempts; attempt++ {
+		if transaction.IsInternal() {
+			err = t.BalanceService.ForceUpdateBalance(transaction.WalletID, transaction.Amount, transaction.Currency)
+		} else {
+			err = t.BalanceService.UpdateBalance(transaction.WalletID, transaction.Amount, transaction.Currency)
+		}
+
+		if !errors.Is(err, repositories.ErrConcurrentModification) {
+			return err
+		}
 	}
 
-	return nil
+	return err
 }
 
 // NewTransactionProcessor returns TransactionProcessor instance.
@@ -46,5 +72,6 @@ func NewTransactionProcessor(db *gorm.DB) TransactionProcessor {
 	return TransactionProcessor{
 		TxRepo:         repositories.NewTransactionRepository(db),
 		BalanceService: NewBalanceService(db),
+		Ledger:         NewLedger(db),
 	}
 }
@@ -1,8 +1,14 @@
 package services_test
 
 import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
 	"wallet/transaction/internal/domain/entities"
 	"wallet/transaction/internal/domain/repositories"
 	"wallet/transaction/internal/domain/services"
@@ -14,9 +20,11 @@ var _ = Describe("Transaction service", func() {
 		transactionProcessor services.TransactionProcessor
 		transactionRepo      *repositories.TransactionRepository
 		balanceRepo          *repositories.BalanceRepository
+		walletID             uuid.UUID
 	)
 
 	BeforeEach(func() {
+		walletID = uuid.New()
 		transactionRepo = repositories.NewTransactionRepository(DB)
 		balanceRepo = repositories.NewBalanceRepository(DB)
 		transactionProcessor = services.NewTransactionProcessor(DB)
@@ -28,17 +36,17 @@ var _ = Describe("Transaction service", func() {
 				var transaction *entities.Transaction
 
 				BeforeEach(func() {
-					transaction = createTransaction(10)
+					transaction = createTransaction(walletID, 10)
 				})
 
 				When("transaction are procesed", func() {
 					BeforeEach(func() {
-						err := transactionProcessor.Execute(transaction)
+						err := transactionProcessor.Execute(context.Background(), transaction)
 						Expect(err).ToNot(HaveOccurred())
 					})
 
 					It("balance should be increased to the amount", func() {
-						balance, err := balanceRepo.Get()
+						balance, err := balanceRepo.Get(walletID)
 						Expect(err).ToNot(HaveOccurred())
 						Expect(balance.Value.Cents).To(Equal(int64(10)))
 					})
@@ -52,25 +60,26 @@ var _ = Describe("Transaction service", func() {
 				)
 
 				BeforeEach(func() {
-					transaction = createTransaction(-10)
+					transaction = createTransaction(walletID, -10)
 				})
 
 				When("transaction are procesed", func() {
 					BeforeEach(func() {
-						err := transactionProcessor.Execute(transaction)
+						err := transactionProcessor.Execute(context.Background(), transaction)
 						Expect(err).ToNot(HaveOccurred())
 					})
 
 					It("balance should not be decreased to the amount", func() {
-						balance, err := balanceRepo.Get()
+						balance, err := balanceRepo.Get(walletID)
 						Expect(err).ToNot(HaveOccurred())
 						Expect(balance.Value.Cents).To(Equal(int64(0)))
 					})
 
-					It("transaction should be cancelled", func() {
+					It("transaction should be cancelled because of insufficient funds", func() {
 						transaction, err = transactionRepo.FindByID(transaction.ID)
 						Expect(err).ToNot(HaveOccurred())
 						Expect(transaction.Status).To(Equal(entities.Cancelled))
+						Expect(*transaction.CancelReason).To(Equal(entities.InsufficientFunds))
 					})
 				})
 			})
@@ -78,7 +87,7 @@ var _ = Describe("Transaction service", func() {
 
 		When("the current balance is 100", func() {
 			BeforeEach(func() {
-				balance := entities.NewBalance(vo.NewTotalAmount(100))
+				balance := entities.NewBalance(walletID, vo.NewTotalAmount(100), vo.DefaultCurrency)
 				err := balanceRepo.Save(balance)
 				Expect(err).ToNot(HaveOccurred())
 			})
@@ -90,17 +99,17 @@ var _ = Describe("Transaction service", func() {
 				)
 
 				BeforeEach(func() {
-					transaction = createTransaction(-10)
+					transaction = createTransaction(walletID, -10)
 				})
 
 				When("transaction are procesed", func() {
 					BeforeEach(func() {
-						err := transactionProcessor.Execute(transaction)
+						err := transactionProcessor.Execute(context.Background(), transaction)
 						Expect(err).ToNot(HaveOccurred())
 					})
 
 					It("balance should be decreased to the amount", func() {
-						balance, err := balanceRepo.Get()
+						balance, err := balanceRepo.Get(walletID)
 						Expect(err).ToNot(HaveOccurred())
 						Expect(balance.Value.Cents).To(Equal(int64(90)))
 					})
@@ -112,6 +121,112 @@ var _ = Describe("Transaction service", func() {
 					})
 				})
 			})
+
+			Context("an unprocessed transaction in another currency are exist", func() {
+				var transaction *entities.Transaction
+
+				BeforeEach(func() {
+					transaction = entities.NewTransaction(uuid.New().String(), walletID, vo.NewAmount(10), vo.Currency("USD"), entities.Win, entities.Game)
+					Expect(transactionRepo.Save(transaction)).To(Succeed())
+				})
+
+				When("transaction are procesed", func() {
+					BeforeEach(func() {
+						err := transactionProcessor.Execute(context.Background(), transaction)
+						Expect(err).ToNot(HaveOccurred())
+					})
+
+					It("transaction should be cancelled because of the currency mismatch", func() {
+						transaction, err := transactionRepo.FindByID(transaction.ID)
+						Expect(err).ToNot(HaveOccurred())
+						Expect(transaction.Status).To(Equal(entities.Cancelled))
+						Expect(*transaction.CancelReason).To(Equal(entities.CurrencyMismatch))
+					})
+				})
+			})
+
+			Context("the balance is modified concurrently", func() {
+				var (
+					transaction *entities.Transaction
+					conflicts   int
+					attempts    int
+				)
+
+				// conflictingProcessor returns a processor whose balance updates are preceded by a concurrent
+				// modification of the balance until the conflicts are used up.
+				conflictingProcessor := func() services.TransactionProcessor {
+					sqlDB, err := DB.DB()
+					Expect(err).ToNot(HaveOccurred())
+					conflictingDB, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{
+						Logger: logger.Default.LogMode(logger.Silent),
+					})
+					Expect(err).ToNot(HaveOccurred())
+
+					err = conflictingDB.Callback().Update().Before("gorm:update").Register("test:conflict", func(tx *gorm.DB) {
+						if tx.Statement.Table != "balances" {
+							return
+						}
+						attempts++
+						if attempts <= conflicts {
+							Expect(DB.Exec("UPDATE balances SET version = version + 1 WHERE wallet_id = ?", walletID).Error).To(Succeed())
+						}
+					})
+					Expect(err).ToNot(HaveOccurred())
+
+					return services.NewTransactionProcessor(conflictingDB)
+				}
+
+				BeforeEach(func() {
+					transaction = createTransaction(walletID, 10)
+					attempts = 0
+				})
+
+				When("the conflicts are resolved by a retry", func() {
+					BeforeEach(func() {
+						conflicts = 2
+						err := conflictingProcessor().Execute(context.Background(), transaction)
+						Expect(err).ToNot(HaveOccurred())
+					})
+
+					It("balance should be updated with the fresh balance", func() {
+						Expect(attempts).To(Equal(3))
+
+						balance, err := balanceRepo.Get(walletID)
+						Expect(err).ToNot(HaveOccurred())
+						Expect(balance.Value.Cents).To(Equal(int64(110)))
+					})
+
+					It("transaction should be done", func() {
+						transaction, err := transactionRepo.FindByID(transaction.ID)
+						Expect(err).ToNot(HaveOccurred())
+						Expect(transaction.Status).To(Equal(entities.Done))
+					})
+				})
+
+				When("the conflicts persist", func() {
+					var err error
+
+					BeforeEach(func() {
+						conflicts = 10
+						err = conflictingProcessor().Execute(context.Background(), transaction)
+					})
+
+					It("processing should give up with the concurrent modification error", func() {
+						Expect(errors.Is(err, repositories.ErrConcurrentModification)).To(BeTrue())
+						Expect(attempts).To(Equal(5))
+					})
+
+					It("balance and transaction should remain unchanged", func() {
+						balance, err := balanceRepo.Get(walletID)
+						Expect(err).ToNot(HaveOccurred())
+						Expect(balance.Value.Cents).To(Equal(int64(100)))
+
+						transaction, err := transactionRepo.FindByID(transaction.ID)
+						Expect(err).ToNot(HaveOccurred())
+						Expect(transaction.Status).To(Equal(entities.New))
+					})
+				})
+			})
 		})
 	})
 })
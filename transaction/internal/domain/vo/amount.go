@@ -2,12 +2,25 @@ package vo
 
 import (
 	"database/sql/driver"
+	"errors"
 	"fmt"
 	"math"
-	"strconv"
 )
 
-// Amount represents a monetary value in cents, suitable for amounts up to the maximum int value in Go.
+// MaxAmountCents is the biggest absolute amount in minor units, transaction amounts are stored in an integer column.
+const MaxAmountCents = math.MaxInt32
+
+// ErrInvalidAmountFormat is returned when the amount is not a plain decimal number.
+var ErrInvalidAmountFormat = errors.New("amount must be a decimal number")
+
+// ErrAmountPrecision is returned when the amount has more fraction digits than the currency allows.
+var ErrAmountPrecision = errors.New("amount has more fraction digits than the currency allows")
+
+// ErrAmountOverflow is returned when the amount does not fit into MaxAmountCents.
+var ErrAmountOverflow = errors.New("amount is too large")
+
+// Amount represents a monetary value in minor units of its currency (cents for EUR), suitable for amounts up to
+// the maximum int value in Go.
 type Amount struct {
 	Cents int
 }
@@ -32,6 +45,16 @@ func (a *Amount) Equal(amount Amount) bool {
 	return a.Cents == amount.Cents
 }
 
+// String returns a string representation of the Amount in the default currency.
+func (a Amount) String() string {
+	return a.Format(DefaultCurrency)
+}
+
+// Format returns a string representation of the Amount, formatted as a decimal with the currency precision.
+func (a Amount) Format(currency Currency) string {
+	return currency.Format(int64(a.Cents))
+}
+
 // Value implements the Valuer interface and returns the amount's value as a driver.Value.
 func (a Amount) Value() (driver.Value, error) {
 	return a.Cents, nil
@@ -68,21 +91,77 @@ func (t *Amount) GreaterThenZero() bool {
 	return t.Cents > 0
 }
 
-// NewAmountFromString creates a new Amount from a string representation, returning an error if the string cannot be parsed.
-func NewAmountFromString(amount string) (Amount, error) {
-	amountFloat, err := strconv.ParseFloat(amount, 64)
+// NewAmountFromString creates a new Amount from a decimal string like "-10.15" in the given currency, returning
+// ErrInvalidAmountFormat, ErrAmountPrecision or ErrAmountOverflow if the string cannot be converted to minor units
+// exactly.
+func NewAmountFromString(amount string, currency Currency) (Amount, error) {
+	cents, err := parseMinorUnits(amount, currency.MinorUnits())
 	if err != nil {
+		return NewAmount(0), fmt.Errorf("%w: %q", err, amount)
+	}
+
+	return NewAmount(cents), nil
+}
+
+// parseMinorUnits converts an optionally signed decimal string with at most precision fraction digits to an integer
+// number of minor units without going through floating point.
+func parseMinorUnits(amount string, precision int) (int, error) {
+	digits := amount
+	negative := false
+	if len(digits) > 0 && (digits[0] == '-' || digits[0] == '+') {
+		negative = digits[0] == '-'
+		digits = digits[1:]
+	}
 
-		return NewAmount(0), err
+	integerPart, fractionPart := digits, ""
+	for i := 0; i < len(digits); i++ {
+		if digits[i] == '.' {
+			integerPart, fractionPart = digits[:i], digits[i+1:]
+			if fractionPart == "" {
+				return 0, ErrInvalidAmountFormat
+			}
+			break
+		}
 	}
 
-	return NewAmountFromFloat(amountFloat), nil
+	if integerPart == "" || !isDigits(integerPart) || !isDigits(fractionPart) {
+		return 0, ErrInvalidAmountFormat
+	}
+
+	if len(fractionPart) > precision {
+		return 0, ErrAmountPrecision
+	}
+
+	value := 0
+	for _, digit := range integerPart + fractionPart {
+		value = value*10 + int(digit-'0')
+		if value > MaxAmountCents {
+			return 0, ErrAmountOverflow
+		}
+	}
+
+	for i := len(fractionPart); i < precision; i++ {
+		value *= 10
+		if value > MaxAmountCents {
+			return 0, ErrAmountOverflow
+		}
+	}
+
+	if negative {
+		value = -value
+	}
+
+	return value, nil
 }
 
-// NewAmountFromFloat creates a new Amount from a float64 value, rounding to the nearest cent.
-func NewAmountFromFloat(amount float64) Amount {
-	amountInt := int(math.Round(amount * 100))
-	return NewAmount(amountInt)
+func isDigits(value string) bool {
+	for i := 0; i < len(value); i++ {
+		if value[i] < '0' || value[i] > '9' {
+			return false
+		}
+	}
+
+	return true
 }
 
 // NewAmount returns NewAmount instance.
@@ -0,0 +1,55 @@
+package vo_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"wallet/transaction/internal/domain/vo"
+)
+
+var _ = Describe("Amount", func() {
+	DescribeTable("is parsed from a decimal string",
+		func(amount string, currency vo.Currency, cents int) {
+			parsed, err := vo.NewAmountFromString(amount, currency)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(parsed.Cents).To(Equal(cents))
+		},
+		Entry("whole number", "10", vo.DefaultCurrency, 1000),
+		Entry("fraction", "10.15", vo.DefaultCurrency, 1015),
+		Entry("single fraction digit", "0.5", vo.DefaultCurrency, 50),
+		Entry("negative", "-10.15", vo.DefaultCurrency, -1015),
+		Entry("explicit plus sign", "+1", vo.DefaultCurrency, 100),
+		Entry("negative zero", "-0.00", vo.DefaultCurrency, 0),
+		Entry("maximum amount", "21474836.47", vo.DefaultCurrency, vo.MaxAmountCents),
+		Entry("negative maximum amount", "-21474836.47", vo.DefaultCurrency, -vo.MaxAmountCents),
+		Entry("JPY without fraction", "1500", vo.Currency("JPY"), 1500),
+		Entry("JPY maximum amount", "2147483647", vo.Currency("JPY"), vo.MaxAmountCents),
+		Entry("BHD with three fraction digits", "0.285", vo.Currency("BHD"), 285),
+		Entry("BHD with one fraction digit", "1.5", vo.Currency("BHD"), 1500),
+		Entry("BHD maximum amount", "2147483.647", vo.Currency("BHD"), vo.MaxAmountCents),
+	)
+
+	DescribeTable("is rejected",
+		func(amount string, currency vo.Currency, expected error) {
+			parsed, err := vo.NewAmountFromString(amount, currency)
+			Expect(err).To(MatchError(expected))
+			Expect(parsed.Cents).To(Equal(0))
+		},
+		Entry("empty", "", vo.DefaultCurrency, vo.ErrInvalidAmountFormat),
+		Entry("sign only", "-", vo.DefaultCurrency, vo.ErrInvalidAmountFormat),
+		Entry("exponent", "1e3", vo.DefaultCurrency, vo.ErrInvalidAmountFormat),
+		Entry("NaN", "NaN", vo.DefaultCurrency, vo.ErrInvalidAmountFormat),
+		Entry("Inf", "Inf", vo.DefaultCurrency, vo.ErrInvalidAmountFormat),
+		Entry("missing integer part", ".5", vo.DefaultCurrency, vo.ErrInvalidAmountFormat),
+		Entry("missing fraction part", "10.", vo.DefaultCurrency, vo.ErrInvalidAmountFormat),
+		Entry("double sign", "--1", vo.DefaultCurrency, vo.ErrInvalidAmountFormat),
+		Entry("thousands separator", "1,000", vo.DefaultCurrency, vo.ErrInvalidAmountFormat),
+		Entry("more fraction digits than EUR allows", "0.285", vo.DefaultCurrency, vo.ErrAmountPrecision),
+		Entry("fraction digits in JPY", "1.5", vo.Currency("JPY"), vo.ErrAmountPrecision),
+		Entry("more fraction digits than BHD allows", "0.2855", vo.Currency("BHD"), vo.ErrAmountPrecision),
+		Entry("one cent over the maximum amount", "21474836.48", vo.DefaultCurrency, vo.ErrAmountOverflow),
+		Entry("one cent under the negative maximum amount", "-21474836.48", vo.DefaultCurrency, vo.ErrAmountOverflow),
+		Entry("one yen over the maximum amount", "2147483648", vo.Currency("JPY"), vo.ErrAmountOverflow),
+		Entry("one fils over the maximum amount", "2147483.648", vo.Currency("BHD"), vo.ErrAmountOverflow),
+		Entry("maximum amount scaled by the precision", "2147483647", vo.DefaultCurrency, vo.ErrAmountOverflow),
+	)
+})
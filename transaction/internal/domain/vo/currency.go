@@ -0,0 +1,69 @@
+package vo
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// DefaultCurrency is the currency of the amounts created before currencies were introduced.
+const DefaultCurrency Currency = "EUR"
+
+// ErrUnsupportedCurrency is returned when the currency code is unknown.
+var ErrUnsupportedCurrency = errors.New("currency is not supported")
+
+// minorUnits contains the number of fraction digits of each supported currency (ISO 4217).
+var minorUnits = map[Currency]int{
+	"EUR": 2,
+	"USD": 2,
+	"GBP": 2,
+	"CHF": 2,
+	"SEK": 2,
+	"NOK": 2,
+	"PLN": 2,
+	"JPY": 0,
+	"KRW": 0,
+	"BHD": 3,
+	"KWD": 3,
+	"OMR": 3,
+}
+
+// Currency represents an ISO 4217 currency code.
+type Currency string
+
+// MinorUnits returns the number of fraction digits of the currency, amounts are stored in minor units.
+func (c Currency) MinorUnits() int {
+	return minorUnits[c]
+}
+
+// Format returns a decimal representation of the amount in minor units with the currency precision.
+func (c Currency) Format(value int64) string {
+	sign := ""
+	if value < 0 {
+		sign = "-"
+	}
+
+	digits := fmt.Sprintf("%d", value)
+	digits = strings.TrimPrefix(digits, "-")
+
+	precision := c.MinorUnits()
+	if precision == 0 {
+		return sign + digits
+	}
+
+	if len(digits) <= precision {
+		digits = strings.Repeat("0", precision-len(digits)+1) + digits
+	}
+
+	return sign + digits[:len(digits)-precision] + "." + digits[len(digits)-precision:]
+}
+
+// NewCurrency returns Currency for the given code, returning ErrUnsupportedCurrency if the code is unknown.
+func NewCurrency(code string) (Currency, error) {
+	currency := Currency(code)
+	if _, ok := minorUnits[currency]; !ok {
+		return "", fmt.Errorf("%w: %q", ErrUnsupportedCurrency, code)
+	}
+
+	return currency, nil
+}
@@ -5,8 +5,8 @@ import (
 	"fmt"
 )
 
-// TotalAmount represents a monetary value in cents, suitable for large amounts such as total balances,
-// using int64 for extended range.
+// TotalAmount represents a monetary value in minor units of its currency, suitable for large amounts such as
+// total balances, using int64 for extended range.
 type TotalAmount struct {
 	Cents int64
 }
@@ -21,9 +21,14 @@ func (t *TotalAmount) LessThanZero() bool {
 	return t.Cents < 0
 }
 
-// String returns a string representation of the TotalAmount, formatted as a decimal with two decimal places.
+// String returns a string representation of the TotalAmount in the default currency.
 func (t TotalAmount) String() string {
-	return fmt.Sprintf("%.2f", float64(t.Cents)/100)
+	return t.Format(DefaultCurrency)
+}
+
+// Format returns a string representation of the TotalAmount, formatted as a decimal with the currency precision.
+func (t TotalAmount) Format(currency Currency) string {
+	return currency.Format(t.Cents)
 }
 
 // Value implements the Valuer interface and returns the total amount's value as a driver.Value.
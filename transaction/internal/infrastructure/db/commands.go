@@ -7,7 +7,7 @@ import (
 
 // Truncate clears all records from the existed tables (Transaction, Correction, Balance) in the database.
 func Truncate(db *gorm.DB) {
-	tables := []interface{}{&entities.Transaction{}, &entities.Correction{}, &entities.Balance{}}
+	tables := []interface{}{&entities.Transaction{}, &entities.Correction{}, &entities.Balance{}, &entities.ManualCorrection{}, &entities.CorrectionRun{}, &entities.Posting{}, &entities.BalanceSnapshot{}, &entities.BalanceDiscrepancy{}}
 	for _, table := range tables {
 		_ = db.Session(&gorm.Session{AllowGlobalUpdate: true}).Delete(table).Error
 	}
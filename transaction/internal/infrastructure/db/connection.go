@@ -9,7 +9,7 @@ import (
 	"gorm.io/gorm"
 	"gorm.io/gorm/logger"
 	"strings"
-	"wallet/transaction/internal/domain/entities"
+	"wallet/transaction/internal/infrastructure/tracing"
 )
 
 // DbConnection holds configuration details for connecting to a database.
@@ -22,19 +22,14 @@ type DbConnection struct {
 }
 
 // Connect establishes a connection to the PostgreSQL database using the config,
-// performs necessary setup, and returns a *gorm.DB instance.
+// performs necessary setup, and returns a *gorm.DB instance. The schema is not migrated.
 func (dbConn DbConnection) Connect(ctx context.Context) *gorm.DB {
-	db := dbConn.doConnection(ctx)
-	err := RunAutoMigrations(db)
-	if err != nil {
-		log.Fatal(ctx, err)
-	}
-
-	return db
+	return dbConn.doConnection(ctx)
 }
 
-func (dbConn DbConnection) doConnection(ctx context.Context) *gorm.DB {
-	dsn := fmt.Sprintf(
+// DSN returns the connection string of the database.
+func (dbConn DbConnection) DSN() string {
+	return fmt.Sprintf(
 		"host=%s port=%s user=%s dbname=%s password=%s sslmode=disable",
 		dbConn.Host,
 		dbConn.Port,
@@ -42,6 +37,10 @@ func (dbConn DbConnection) doConnection(ctx context.Context) *gorm.DB {
 		dbConn.DbName,
 		dbConn.Password,
 	)
+}
+
+func (dbConn DbConnection) doConnection(ctx context.Context) *gorm.DB {
+	dsn := dbConn.DSN()
 
 	log.Printf(ctx, "Connecting to database with DSN: %s", dsn)
 
@@ -53,6 +52,10 @@ func (dbConn DbConnection) doConnection(ctx context.Context) *gorm.DB {
 		panic(err)
 	}
 
+	if err := db.Use(tracing.NewGormPlugin()); err != nil {
+		panic(err)
+	}
+
 	if err := db.Exec("CREATE EXTENSION IF NOT EXISTS \"uuid-ossp\"").Error; err != nil {
 		log.Fatal(ctx, err)
 	}
@@ -61,15 +64,14 @@ func (dbConn DbConnection) doConnection(ctx context.Context) *gorm.DB {
 }
 
 // ConnectToSchema establishes a database connection, switches to the specified schema,
-// and runs auto-migrations, returning the *gorm.DB instance.
+// and applies the pending migrations, returning the *gorm.DB instance.
 func (dbConn DbConnection) ConnectToSchema(ctx context.Context, schemaName string) (*gorm.DB, error) {
 	db := dbConn.doConnection(ctx)
 	err := SwitchSchema(db, schemaName)
 	if err != nil {
 		return nil, err
 	}
-	err = RunAutoMigrations(db)
-
+	_, err = NewMigrator(db).Up()
 	if err != nil {
 		return nil, err
 	}
@@ -77,24 +79,6 @@ func (dbConn DbConnection) ConnectToSchema(ctx context.Context, schemaName strin
 	return db, nil
 }
 
-// RunAutoMigrations performs auto-migrations for the Transaction, Correction, and Balance entities.
-func RunAutoMigrations(db *gorm.DB) error {
-	err := db.AutoMigrate(&entities.Transaction{})
-	if err != nil {
-		return err
-	}
-	err = db.AutoMigrate(&entities.Correction{})
-	if err != nil {
-		return err
-	}
-	err = db.AutoMigrate(&entities.Balance{})
-	if err != nil {
-		return err
-	}
-
-	return nil
-}
-
 // SwitchSchema creates the specified schema if it doesn't exist and sets it as the search path for the database connection.
 func SwitchSchema(db *gorm.DB, schemaName string) error {
 	err := CreateSchemaIfNotExists(db, schemaName)
@@ -0,0 +1,43 @@
+package db
+
+import (
+	"context"
+	"database/sql/driver"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/pkg/errors"
+	"net"
+	"strings"
+)
+
+// unavailableErrorClasses are the PostgreSQL error classes of a database which cannot serve the query right now:
+// connection exception, insufficient resources and operator intervention, e.g. a shutdown.
+var unavailableErrorClasses = []string{"08", "53", "57P"}
+
+// IsUnavailable returns true if the error is caused by a database outage, i.e. the database cannot be reached or
+// cannot serve the query right now, rather than by the query itself.
+func IsUnavailable(err error) bool {
+	if errors.Is(err, driver.ErrBadConn) || errors.Is(err, context.DeadlineExceeded) || pgconn.Timeout(err) {
+		return true
+	}
+
+	var connectErr *pgconn.ConnectError
+	if errors.As(err, &connectErr) {
+		return true
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return true
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		for _, class := range unavailableErrorClasses {
+			if strings.HasPrefix(pgErr.Code, class) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
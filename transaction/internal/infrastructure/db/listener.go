@@ -0,0 +1,63 @@
+package db
+
+import (
+	"context"
+	"github.com/jackc/pgx/v5"
+	"goa.design/clue/log"
+	"time"
+)
+
+// listenerReconnectDelay is the delay before the listener reconnects after losing its connection.
+const listenerReconnectDelay = time.Second
+
+// Listen starts a background goroutine that listens for the notifications on the given channel on a dedicated
+// connection until the context is done. The returned channel receives a signal when at least one notification
+// arrived since the last signal was consumed. The connection is re-established when it is lost.
+func (dbConn DbConnection) Listen(ctx context.Context, channel string) <-chan struct{} {
+	notifications := make(chan struct{}, 1)
+
+	go func() {
+		for {
+			err := listen(ctx, dbConn.DSN(), channel, notifications)
+			if ctx.Err() != nil {
+				return
+			}
+			log.Errorf(ctx, err, "Listening on %s failed, reconnecting", channel)
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(listenerReconnectDelay):
+			}
+		}
+	}()
+
+	return notifications
+}
+
+func listen(ctx context.Context, dsn string, channel string, notifications chan<- struct{}) error {
+	conn, err := pgx.Connect(ctx, dsn)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = conn.Close(context.Background())
+	}()
+
+	_, err = conn.Exec(ctx, "LISTEN "+pgx.Identifier{channel}.Sanitize())
+	if err != nil {
+		return err
+	}
+
+	for {
+		_, err = conn.WaitForNotification(ctx)
+		if err != nil {
+			return err
+		}
+
+		select {
+		case notifications <- struct{}{}:
+		default:
+		}
+	}
+}
@@ -0,0 +1,231 @@
+package db
+
+import (
+	"embed"
+	"fmt"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+	"io/fs"
+	"regexp"
+	"sort"
+	"strconv"
+	"time"
+)
+
+//go:embed migrations/*.sql
+var migrationFiles embed.FS
+
+// migrationLockKey is the key of the advisory lock held while the migrations are applied, so only one replica
+// migrates the schema at a time.
+const migrationLockKey = 7411900001
+
+var migrationFileName = regexp.MustCompile(`^(\d+)_(\w+)\.(up|down)\.sql$`)
+
+// Migration is a versioned schema change with the SQL applying and reverting it.
+type Migration struct {
+	Version int64
+	Name    string
+	Up      string
+	Down    string
+}
+
+// MigrationRecord represents the schema_migrations row of an applied migration.
+type MigrationRecord struct {
+	Version   int64     `gorm:"primaryKey;autoIncrement:false"`
+	Name      string    `gorm:"type:varchar(255);not null"`
+	AppliedAt time.Time `gorm:"type:timestamptz;not null;default:current_timestamp"`
+}
+
+// TableName returns the name of the table the applied migrations are recorded in.
+func (MigrationRecord) TableName() string {
+	return "schema_migrations"
+}
+
+// MigrationStatus is a migration and whether it is applied.
+type MigrationStatus struct {
+	Migration
+	Applied bool
+}
+
+// Migrator applies and reverts the migrations embedded into the binary.
+type Migrator struct {
+	db *gorm.DB
+}
+
+// Up applies all pending migrations in the version order within a single database transaction and returns them.
+func (m Migrator) Up() ([]Migration, error) {
+	migrations, err := loadMigrations()
+	if err != nil {
+		return nil, err
+	}
+
+	var applied []Migration
+	err = m.locked(func(tx *gorm.DB, versions map[int64]bool) error {
+		for _, migration := range migrations {
+			if versions[migration.Version] {
+				continue
+			}
+
+			if err := tx.Exec(migration.Up).Error; err != nil {
+				return errors.Wrapf(err, "cannot apply migration %d_%s", migration.Version, migration.Name)
+			}
+			if err := tx.Create(&MigrationRecord{Version: migration.Version, Name: migration.Name, AppliedAt: time.Now()}).Error; err != nil {
+				return err
+			}
+			applied = append(applied, migration)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return applied, nil
+}
+
+// Down reverts up to the given number of the latest applied migrations within a single database transaction
+// and returns them.
+func (m Migrator) Down(steps int) ([]Migration, error) {
+	migrations, err := loadMigrations()
+	if err != nil {
+		return nil, err
+	}
+
+	var reverted []Migration
+	err = m.locked(func(tx *gorm.DB, versions map[int64]bool) error {
+		for i := len(migrations) - 1; i >= 0 && len(reverted) < steps; i-- {
+			migration := migrations[i]
+			if !versions[migration.Version] {
+				continue
+			}
+
+			if err := tx.Exec(migration.Down).Error; err != nil {
+				return errors.Wrapf(err, "cannot revert migration %d_%s", migration.Version, migration.Name)
+			}
+			if err := tx.Delete(&MigrationRecord{}, migration.Version).Error; err != nil {
+				return err
+			}
+			reverted = append(reverted, migration)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return reverted, nil
+}
+
+// Status returns all migrations in the version order and whether they are applied.
+func (m Migrator) Status() ([]MigrationStatus, error) {
+	migrations, err := loadMigrations()
+	if err != nil {
+		return nil, err
+	}
+
+	var statuses []MigrationStatus
+	err = m.locked(func(tx *gorm.DB, versions map[int64]bool) error {
+		for _, migration := range migrations {
+			statuses = append(statuses, MigrationStatus{Migration: migration, Applied: versions[migration.Version]})
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return statuses, nil
+}
+
+// locked runs the function within a database transaction holding the migration advisory lock, passing the versions
+// of the applied migrations.
+func (m Migrator) locked(fn func(tx *gorm.DB, versions map[int64]bool) error) error {
+	return m.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("SELECT pg_advisory_xact_lock(?)", migrationLockKey).Error; err != nil {
+			return errors.Wrap(err, "cannot acquire migration lock")
+		}
+
+		err := tx.Exec(`CREATE TABLE IF NOT EXISTS schema_migrations (
+			version    bigint PRIMARY KEY,
+			name       varchar(255) NOT NULL,
+			applied_at timestamptz NOT NULL DEFAULT current_timestamp
+		)`).Error
+		if err != nil {
+			return errors.Wrap(err, "cannot create schema_migrations table")
+		}
+
+		var records []MigrationRecord
+		if err := tx.Find(&records).Error; err != nil {
+			return errors.Wrap(err, "cannot read applied migrations")
+		}
+
+		versions := make(map[int64]bool, len(records))
+		for _, record := range records {
+			versions[record.Version] = true
+		}
+
+		return fn(tx, versions)
+	})
+}
+
+// loadMigrations reads the embedded migration files, every migration must have both the up and the down file.
+func loadMigrations() ([]Migration, error) {
+	files, err := fs.ReadDir(migrationFiles, "migrations")
+	if err != nil {
+		return nil, err
+	}
+
+	byVersion := make(map[int64]*Migration)
+	for _, file := range files {
+		match := migrationFileName.FindStringSubmatch(file.Name())
+		if match == nil {
+			return nil, fmt.Errorf("invalid migration file name %q", file.Name())
+		}
+
+		version, err := strconv.ParseInt(match[1], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+
+		content, err := migrationFiles.ReadFile("migrations/" + file.Name())
+		if err != nil {
+			return nil, err
+		}
+
+		migration, ok := byVersion[version]
+		if !ok {
+			migration = &Migration{Version: version, Name: match[2]}
+			byVersion[version] = migration
+		}
+		if migration.Name != match[2] {
+			return nil, fmt.Errorf("migration %d has different names %q and %q", version, migration.Name, match[2])
+		}
+
+		if match[3] == "up" {
+			migration.Up = string(content)
+		} else {
+			migration.Down = string(content)
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, migration := range byVersion {
+		if migration.Up == "" || migration.Down == "" {
+			return nil, fmt.Errorf("migration %d_%s must have both up and down files", migration.Version, migration.Name)
+		}
+		migrations = append(migrations, *migration)
+	}
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+
+	return migrations, nil
+}
+
+// NewMigrator returns Migrator instance.
+func NewMigrator(db *gorm.DB) Migrator {
+	return Migrator{db: db}
+}
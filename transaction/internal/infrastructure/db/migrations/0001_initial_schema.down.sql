@@ -0,0 +1,8 @@
+DROP TABLE IF EXISTS balance_discrepancies;
+DROP TABLE IF EXISTS balance_snapshots;
+DROP TABLE IF EXISTS postings;
+DROP TABLE IF EXISTS correction_runs;
+DROP TABLE IF EXISTS manual_corrections;
+DROP TABLE IF EXISTS balances;
+DROP TABLE IF EXISTS corrections;
+DROP TABLE IF EXISTS transactions;
@@ -0,0 +1,153 @@
+-- The initial schema matches the tables created by gorm AutoMigrate in the last release before the migrations, so
+-- a database of that release is adopted without changes. Databases of older releases, e.g. with varchar transaction
+-- IDs and without wallets, cannot be adopted: the migration fails listing the incompatible columns and such a database
+-- must be migrated by hand or recreated empty.
+
+DO $$
+DECLARE
+    incompatible text;
+BEGIN
+    SELECT string_agg(expected.table_name || '.' || expected.column_name, ', ')
+    INTO incompatible
+    FROM (VALUES
+        ('transactions', 'id', 'uuid'), ('transactions', 'external_id', NULL), ('transactions', 'wallet_id', 'uuid'),
+        ('transactions', 'currency', NULL), ('transactions', 'reversed_by_id', NULL),
+        ('transactions', 'processed_at', NULL), ('transactions', 'reversed_at', NULL),
+        ('corrections', 'wallet_id', 'uuid'),
+        ('balances', 'wallet_id', 'uuid'), ('balances', 'currency', NULL), ('balances', 'version', NULL),
+        ('correction_runs', 'currency', NULL), ('postings', 'currency', NULL)
+    ) AS expected (table_name, column_name, data_type)
+    WHERE EXISTS (
+        SELECT 1 FROM information_schema.tables
+        WHERE table_schema = current_schema() AND table_name = expected.table_name
+    ) AND NOT EXISTS (
+        SELECT 1 FROM information_schema.columns
+        WHERE table_schema = current_schema() AND table_name = expected.table_name
+          AND column_name = expected.column_name AND (expected.data_type IS NULL OR data_type = expected.data_type)
+    );
+
+    IF incompatible IS NOT NULL THEN
+        RAISE EXCEPTION 'schema is older than the migrations and cannot be adopted, incompatible columns: %', incompatible
+            USING HINT = 'migrate the database by hand or recreate it empty';
+    END IF;
+END
+$$;
+
+CREATE TABLE IF NOT EXISTS transactions (
+    id             uuid PRIMARY KEY,
+    external_id    varchar(128) NOT NULL,
+    wallet_id      uuid NOT NULL,
+    status         varchar(10),
+    source_type    varchar(10),
+    action         varchar(10),
+    amount         integer,
+    currency       varchar(3) NOT NULL DEFAULT 'EUR',
+    reversed_by_id uuid DEFAULT NULL,
+    processed_at   timestamptz DEFAULT NULL,
+    reversed_at    timestamptz DEFAULT NULL,
+    lock_uuid      uuid DEFAULT NULL,
+    locked_at      timestamptz DEFAULT NULL,
+    created_at     timestamptz DEFAULT current_timestamp,
+    updated_at     timestamptz DEFAULT current_timestamp,
+    CONSTRAINT chk_transactions_status CHECK (status IN ('new', 'done', 'cancelled', 'locked')),
+    CONSTRAINT chk_transactions_source_type CHECK (source_type IN ('game', 'server', 'payment', 'internal')),
+    CONSTRAINT chk_transactions_action CHECK (action IN ('win', 'lost'))
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_transactions_source_external_id ON transactions (source_type, external_id);
+CREATE INDEX IF NOT EXISTS idx_transactions_wallet_id ON transactions (wallet_id);
+CREATE INDEX IF NOT EXISTS idx_transactions_status ON transactions (status);
+CREATE INDEX IF NOT EXISTS idx_transactions_reversed_by_id ON transactions (reversed_by_id);
+CREATE INDEX IF NOT EXISTS idx_transactions_processed_at ON transactions (processed_at);
+CREATE INDEX IF NOT EXISTS idx_transactions_created_at ON transactions (created_at);
+
+-- transactions processed before the processing times were recorded
+UPDATE transactions SET processed_at = updated_at WHERE processed_at IS NULL AND status IN ('done', 'cancelled');
+UPDATE transactions SET reversed_at = updated_at WHERE reversed_at IS NULL AND reversed_by_id IS NOT NULL;
+
+CREATE TABLE IF NOT EXISTS corrections (
+    id         uuid PRIMARY KEY,
+    wallet_id  uuid NOT NULL,
+    done_at    timestamptz,
+    status     varchar(10),
+    lock_uuid  uuid DEFAULT NULL,
+    locked_at  timestamptz DEFAULT NULL,
+    created_at timestamptz DEFAULT current_timestamp,
+    updated_at timestamptz DEFAULT current_timestamp,
+    CONSTRAINT chk_corrections_status CHECK (status IN ('ready', 'locked'))
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_corrections_wallet_id ON corrections (wallet_id);
+CREATE INDEX IF NOT EXISTS idx_corrections_status ON corrections (status);
+
+CREATE TABLE IF NOT EXISTS balances (
+    id        uuid PRIMARY KEY,
+    wallet_id uuid NOT NULL,
+    value     bigint NOT NULL,
+    currency  varchar(3) NOT NULL DEFAULT 'EUR',
+    version   bigint NOT NULL DEFAULT 0
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_balances_wallet_id ON balances (wallet_id);
+
+CREATE TABLE IF NOT EXISTS manual_corrections (
+    id              uuid PRIMARY KEY,
+    wallet_id       uuid NOT NULL,
+    transaction_ids jsonb NOT NULL,
+    compensation_id uuid DEFAULT NULL,
+    reason          text NOT NULL,
+    requested_by    varchar(128) NOT NULL,
+    created_at      timestamptz DEFAULT current_timestamp
+);
+CREATE INDEX IF NOT EXISTS idx_manual_corrections_wallet_id ON manual_corrections (wallet_id);
+
+CREATE TABLE IF NOT EXISTS correction_runs (
+    id              uuid PRIMARY KEY,
+    kind            varchar(10) NOT NULL,
+    wallet_id       uuid NOT NULL,
+    transaction_ids jsonb NOT NULL,
+    compensation_id uuid DEFAULT NULL,
+    delta           integer NOT NULL,
+    currency        varchar(3) NOT NULL DEFAULT 'EUR',
+    created_at      timestamptz DEFAULT current_timestamp,
+    finished_at     timestamptz DEFAULT NULL,
+    CONSTRAINT chk_correction_runs_kind CHECK (kind IN ('automatic', 'manual'))
+);
+CREATE INDEX IF NOT EXISTS idx_correction_runs_wallet_id ON correction_runs (wallet_id);
+CREATE INDEX IF NOT EXISTS idx_correction_runs_created_at ON correction_runs (created_at);
+
+CREATE TABLE IF NOT EXISTS postings (
+    id             uuid PRIMARY KEY,
+    transaction_id uuid NOT NULL,
+    wallet_id      uuid NOT NULL,
+    account        varchar(10) NOT NULL,
+    direction      varchar(6) NOT NULL,
+    amount         bigint NOT NULL,
+    currency       varchar(3) NOT NULL DEFAULT 'EUR',
+    created_at     timestamptz DEFAULT current_timestamp,
+    CONSTRAINT chk_postings_account CHECK (account IN ('wallet', 'game', 'server', 'payment', 'internal')),
+    CONSTRAINT chk_postings_direction CHECK (direction IN ('debit', 'credit')),
+    CONSTRAINT chk_postings_amount CHECK (amount > 0)
+);
+CREATE INDEX IF NOT EXISTS idx_postings_transaction_id ON postings (transaction_id);
+CREATE INDEX IF NOT EXISTS idx_postings_wallet_account ON postings (wallet_id, account);
+CREATE INDEX IF NOT EXISTS idx_postings_created_at ON postings (created_at);
+
+CREATE TABLE IF NOT EXISTS balance_snapshots (
+    id         uuid PRIMARY KEY,
+    wallet_id  uuid NOT NULL,
+    value      bigint NOT NULL,
+    currency   varchar(3) NOT NULL DEFAULT 'EUR',
+    taken_at   timestamptz NOT NULL,
+    created_at timestamptz DEFAULT current_timestamp
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_balance_snapshots_wallet_taken_at ON balance_snapshots (wallet_id, taken_at);
+
+CREATE TABLE IF NOT EXISTS balance_discrepancies (
+    id          uuid PRIMARY KEY,
+    wallet_id   uuid NOT NULL,
+    stored      bigint NOT NULL,
+    calculated  bigint NOT NULL,
+    currency    varchar(3) NOT NULL DEFAULT 'EUR',
+    repaired    boolean NOT NULL DEFAULT false,
+    detected_at timestamptz DEFAULT current_timestamp
+);
+CREATE INDEX IF NOT EXISTS idx_balance_discrepancies_wallet_id ON balance_discrepancies (wallet_id);
+CREATE INDEX IF NOT EXISTS idx_balance_discrepancies_detected_at ON balance_discrepancies (detected_at);
@@ -0,0 +1,2 @@
+-- W3C trace context of the request which created the transaction, continued by the balance worker
+ALTER TABLE transactions ADD COLUMN IF NOT EXISTS traceparent varchar(55) DEFAULT NULL;
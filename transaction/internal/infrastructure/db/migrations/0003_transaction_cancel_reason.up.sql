@@ -0,0 +1,12 @@
+-- reason the transaction was cancelled for, so a cancellation is reported as what caused it
+ALTER TABLE transactions ADD COLUMN IF NOT EXISTS cancel_reason varchar(20) DEFAULT NULL;
+
+-- transactions cancelled before the reason was recorded
+UPDATE transactions SET cancel_reason = 'reversed'
+WHERE status = 'cancelled' AND cancel_reason IS NULL AND reversed_at IS NOT NULL;
+UPDATE transactions SET cancel_reason = 'currency_mismatch'
+FROM balances
+WHERE transactions.wallet_id = balances.wallet_id AND transactions.currency <> balances.currency
+  AND transactions.status = 'cancelled' AND transactions.cancel_reason IS NULL;
+UPDATE transactions SET cancel_reason = 'insufficient_funds'
+WHERE status = 'cancelled' AND cancel_reason IS NULL;
@@ -0,0 +1,2 @@
+DELETE FROM postings WHERE backfilled;
+ALTER TABLE postings DROP COLUMN IF EXISTS backfilled;
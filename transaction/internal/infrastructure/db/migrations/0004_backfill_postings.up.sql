@@ -0,0 +1,16 @@
+-- postings of the transactions processed before the ledger was introduced, so the balances derived from the ledger
+-- match the stored balances; reversed transactions keep their postings, as the compensation posts the correction
+-- the backfilled postings are flagged, so reverting the migration removes exactly them
+ALTER TABLE postings ADD COLUMN IF NOT EXISTS backfilled boolean NOT NULL DEFAULT false;
+
+INSERT INTO postings (id, transaction_id, wallet_id, account, direction, amount, currency, created_at, backfilled)
+SELECT gen_random_uuid(), transactions.id, transactions.wallet_id, entry.account, entry.direction,
+       abs(transactions.amount), transactions.currency, COALESCE(transactions.processed_at, transactions.updated_at),
+       true
+FROM transactions
+CROSS JOIN LATERAL (VALUES
+    ('wallet', CASE WHEN transactions.amount > 0 THEN 'credit' ELSE 'debit' END),
+    (transactions.source_type, CASE WHEN transactions.amount > 0 THEN 'debit' ELSE 'credit' END)
+) AS entry (account, direction)
+WHERE (transactions.status = 'done' OR transactions.reversed_at IS NOT NULL) AND transactions.amount <> 0
+  AND NOT EXISTS (SELECT 1 FROM postings WHERE postings.transaction_id = transactions.id);
@@ -0,0 +1,129 @@
+package metrics
+
+import (
+	"context"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"goa.design/clue/log"
+)
+
+// namespace prefixes the names of all metrics of the service.
+const namespace = "wallet"
+
+var transactionLabels = []string{"source_type", "action"}
+
+// TransactionsCreated counts the accepted transactions.
+var TransactionsCreated = promauto.NewCounterVec(prometheus.CounterOpts{
+	Namespace: namespace,
+	Name:      "transactions_created_total",
+	Help:      "Number of accepted transactions.",
+}, transactionLabels)
+
+// TransactionsDuplicated counts the transactions sent again, both the replayed and the conflicting ones.
+var TransactionsDuplicated = promauto.NewCounterVec(prometheus.CounterOpts{
+	Namespace: namespace,
+	Name:      "transactions_duplicated_total",
+	Help:      "Number of transactions sent again with an already used transaction ID.",
+}, transactionLabels)
+
+// TransactionsDone counts the transactions applied to the balance.
+var TransactionsDone = promauto.NewCounterVec(prometheus.CounterOpts{
+	Namespace: namespace,
+	Name:      "transactions_done_total",
+	Help:      "Number of transactions applied to the balance.",
+}, transactionLabels)
+
+// TransactionsCancelled counts the transactions rejected by the processing or reversed by a correction.
+var TransactionsCancelled = promauto.NewCounterVec(prometheus.CounterOpts{
+	Namespace: namespace,
+	Name:      "transactions_cancelled_total",
+	Help:      "Number of transactions rejected by the processing or reversed by a correction.",
+}, transactionLabels)
+
+// ProcessingLatency observes the time from the creation of a transaction until it is done.
+var ProcessingLatency = promauto.NewHistogramVec(prometheus.HistogramOpts{
+	Namespace: namespace,
+	Name:      "transaction_processing_latency_seconds",
+	Help:      "Time from the creation of a transaction until it is applied to the balance.",
+	Buckets:   []float64{.01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10, 30, 60},
+}, []string{"source_type"})
+
+// WorkerBatchDuration observes the duration of the worker rounds.
+var WorkerBatchDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+	Namespace: namespace,
+	Name:      "worker_batch_duration_seconds",
+	Help:      "Duration of a worker round processing a batch.",
+	Buckets:   prometheus.DefBuckets,
+}, []string{"worker"})
+
+// CorrectionRuns counts the correction runs which cancelled transactions.
+var CorrectionRuns = promauto.NewCounterVec(prometheus.CounterOpts{
+	Namespace: namespace,
+	Name:      "correction_runs_total",
+	Help:      "Number of correction runs which cancelled transactions.",
+}, []string{"kind"})
+
+// BalanceDiscrepancies reports the number of balance discrepancies detected by the last reconciliation.
+var BalanceDiscrepancies = promauto.NewGauge(prometheus.GaugeOpts{
+	Namespace: namespace,
+	Name:      "balance_discrepancies",
+	Help:      "Number of wallets whose stored balance differed from their transactions in the last reconciliation.",
+})
+
+// TransactionLabels returns the labels of the transaction counters.
+func TransactionLabels(sourceType string, action string) prometheus.Labels {
+	return prometheus.Labels{"source_type": sourceType, "action": action}
+}
+
+// State reads the state of the database shared by all instances: the sum of the current wallet balances by currency
+// in major units and the number of transactions waiting for the processing by status.
+type State interface {
+	BalanceSums() (map[string]float64, error)
+	QueuedTransactions() (map[string]int64, error)
+}
+
+// StateCollector collects the gauges which reflect the state of the database shared by all instances: the sum of
+// the wallet balances per currency and the number of new and locked transactions. The gauges are read when scraped.
+type StateCollector struct {
+	state      State
+	balance    *prometheus.Desc
+	queueDepth *prometheus.Desc
+}
+
+// Describe sends the descriptions of the collected gauges.
+func (c StateCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.balance
+	ch <- c.queueDepth
+}
+
+// Collect reads the gauges from the state, a gauge which cannot be read is skipped.
+func (c StateCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx := context.Background()
+
+	sums, err := c.state.BalanceSums()
+	if err != nil {
+		log.Errorf(ctx, err, "Cannot collect balance metric")
+	}
+	for currency, sum := range sums {
+		ch <- prometheus.MustNewConstMetric(c.balance, prometheus.GaugeValue, sum, currency)
+	}
+
+	counts, err := c.state.QueuedTransactions()
+	if err != nil {
+		log.Errorf(ctx, err, "Cannot collect queue depth metric")
+	}
+	for status, count := range counts {
+		ch <- prometheus.MustNewConstMetric(c.queueDepth, prometheus.GaugeValue, float64(count), status)
+	}
+}
+
+// NewStateCollector returns StateCollector instance reading the gauges from the given state.
+func NewStateCollector(state State) StateCollector {
+	return StateCollector{
+		state: state,
+		balance: prometheus.NewDesc(prometheus.BuildFQName(namespace, "", "balance"),
+			"Sum of the current balances of all wallets in the currency.", []string{"currency"}, nil),
+		queueDepth: prometheus.NewDesc(prometheus.BuildFQName(namespace, "", "transactions_queued"),
+			"Number of transactions waiting for the processing by status.", []string{"status"}, nil),
+	}
+}
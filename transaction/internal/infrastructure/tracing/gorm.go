@@ -0,0 +1,90 @@
+package tracing
+
+import (
+	"errors"
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+)
+
+// spanKey is the key of the query span in the gorm statement settings.
+const spanKey = "tracing:span"
+
+// GormPlugin starts a client span for every query run by gorm within a trace: the span is a child of the span in the
+// context of the statement, see gorm.DB.WithContext. Queries without a span in the context are not traced.
+type GormPlugin struct{}
+
+// Name returns the name of the plugin.
+func (GormPlugin) Name() string {
+	return "tracing"
+}
+
+// Initialize registers the callbacks starting and ending the query spans.
+func (p GormPlugin) Initialize(db *gorm.DB) error {
+	callbacks := []struct {
+		operation string
+		before    func(name string, fn func(*gorm.DB)) error
+		after     func(name string, fn func(*gorm.DB)) error
+	}{
+		{"create", db.Callback().Create().Before("gorm:create").Register, db.Callback().Create().After("gorm:create").Register},
+		{"query", db.Callback().Query().Before("gorm:query").Register, db.Callback().Query().After("gorm:query").Register},
+		{"update", db.Callback().Update().Before("gorm:update").Register, db.Callback().Update().After("gorm:update").Register},
+		{"delete", db.Callback().Delete().Before("gorm:delete").Register, db.Callback().Delete().After("gorm:delete").Register},
+		{"row", db.Callback().Row().Before("gorm:row").Register, db.Callback().Row().After("gorm:row").Register},
+		{"raw", db.Callback().Raw().Before("gorm:raw").Register, db.Callback().Raw().After("gorm:raw").Register},
+	}
+
+	for _, callback := range callbacks {
+		if err := callback.before("tracing:before_"+callback.operation, p.start(callback.operation)); err != nil {
+			return err
+		}
+		if err := callback.after("tracing:after_"+callback.operation, p.end); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (GormPlugin) start(operation string) func(*gorm.DB) {
+	return func(db *gorm.DB) {
+		ctx := db.Statement.Context
+		if ctx == nil || !trace.SpanContextFromContext(ctx).IsValid() {
+			return
+		}
+
+		_, span := Tracer().Start(ctx, "gorm."+operation,
+			trace.WithSpanKind(trace.SpanKindClient),
+			trace.WithAttributes(semconv.DBSystemPostgreSQL, attribute.String("db.operation", operation)))
+		db.Statement.Settings.Store(spanKey, span)
+	}
+}
+
+func (GormPlugin) end(db *gorm.DB) {
+	value, ok := db.Statement.Settings.LoadAndDelete(spanKey)
+	if !ok {
+		return
+	}
+	span := value.(trace.Span)
+
+	if db.Statement.Table != "" {
+		span.SetAttributes(semconv.DBSQLTable(db.Statement.Table))
+	}
+	span.SetAttributes(
+		semconv.DBStatement(db.Statement.SQL.String()),
+		attribute.Int64("db.rows_affected", db.Statement.RowsAffected),
+	)
+
+	// a missing record is an expected outcome of a query, not a failure
+	err := db.Statement.Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		err = nil
+	}
+	End(span, err)
+}
+
+// NewGormPlugin returns GormPlugin instance.
+func NewGormPlugin() GormPlugin {
+	return GormPlugin{}
+}
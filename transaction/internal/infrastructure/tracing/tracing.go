@@ -0,0 +1,115 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+	"goa.design/clue/clue"
+	"goa.design/clue/log"
+)
+
+// ServiceName is the name the spans of the service are reported under.
+const ServiceName = "wallet"
+
+// Span exporters.
+const (
+	ExporterNone   = "none"
+	ExporterOTLP   = "otlp"
+	ExporterStdout = "stdout"
+)
+
+// traceParentHeader is the W3C trace context header carrying the trace and the parent span IDs.
+const traceParentHeader = "traceparent"
+
+// Tracer returns the tracer of the service.
+func Tracer() trace.Tracer {
+	return otel.Tracer(ServiceName)
+}
+
+// Setup configures OpenTelemetry to export the spans with the given exporter: to the OTLP HTTP collector at the
+// endpoint or to the standard output. The spans are not recorded with the none exporter. At most the given number
+// of traces per second is sampled. The returned function flushes the pending spans.
+func Setup(ctx context.Context, exporter string, endpoint string, maxSamplingRate int) (func(), error) {
+	var (
+		spanExporter sdktrace.SpanExporter
+		err          error
+	)
+	switch exporter {
+	case ExporterNone, "":
+		return func() {}, nil
+	case ExporterOTLP:
+		spanExporter, _, err = clue.NewHTTPSpanExporter(ctx, otlptracehttp.WithEndpoint(endpoint), otlptracehttp.WithInsecure())
+	case ExporterStdout:
+		spanExporter, err = stdouttrace.New()
+	default:
+		return nil, fmt.Errorf("unknown span exporter %q", exporter)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	cfg, err := clue.NewConfig(ctx, ServiceName, "", nil, spanExporter, clue.WithMaxSamplingRate(maxSamplingRate))
+	if err != nil {
+		return nil, err
+	}
+	clue.ConfigureOpenTelemetry(ctx, cfg)
+
+	return func() {
+		provider, ok := cfg.TracerProvider.(*sdktrace.TracerProvider)
+		if !ok {
+			return
+		}
+
+		// the context of the service is done by now
+		if err := provider.Shutdown(context.Background()); err != nil {
+			log.Errorf(ctx, err, "Cannot flush spans")
+		}
+	}, nil
+}
+
+// TraceParent returns the W3C trace context of the span in the context, or nil if there is no span.
+func TraceParent(ctx context.Context) *string {
+	if !trace.SpanContextFromContext(ctx).IsValid() {
+		return nil
+	}
+
+	carrier := propagation.MapCarrier{}
+	propagation.TraceContext{}.Inject(ctx, carrier)
+	traceParent := carrier.Get(traceParentHeader)
+	if traceParent == "" {
+		return nil
+	}
+
+	return &traceParent
+}
+
+// StartLinked starts a span of a new trace linked to the span of the given W3C trace context, so work done
+// asynchronously, e.g. by a worker, can be followed from the trace which requested it.
+func StartLinked(ctx context.Context, name string, traceParent *string, attributes ...attribute.KeyValue) (context.Context, trace.Span) {
+	opts := []trace.SpanStartOption{trace.WithNewRoot(), trace.WithAttributes(attributes...)}
+	if traceParent != nil {
+		carrier := propagation.MapCarrier{traceParentHeader: *traceParent}
+		linked := trace.SpanContextFromContext(propagation.TraceContext{}.Extract(context.Background(), carrier))
+		if linked.IsValid() {
+			opts = append(opts, trace.WithLinks(trace.Link{SpanContext: linked}))
+		}
+	}
+
+	return Tracer().Start(ctx, name, opts...)
+}
+
+// End records the error in the span, if any, and ends the span.
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
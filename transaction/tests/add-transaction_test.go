@@ -2,9 +2,12 @@ package tests
 
 import (
 	"context"
+	"errors"
 	"github.com/google/uuid"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"go.opentelemetry.io/otel/trace"
+	goa "goa.design/goa/v3/pkg"
 	"wallet/gen/transaction"
 	"wallet/transaction/internal/domain/entities"
 	"wallet/transaction/internal/domain/repositories"
@@ -21,6 +24,7 @@ var _ = Describe("transaction management", func() {
 				State:         entities.Win,
 				Amount:        "0",
 				TransactionID: uuid.New().String(),
+				WalletID:      uuid.New().String(),
 				SourceType:    entities.Game,
 			}
 
@@ -28,7 +32,7 @@ var _ = Describe("transaction management", func() {
 		})
 		When("a transaction with 0 amount received", func() {
 			BeforeEach(func(ctx context.Context) {
-				err := client.Create(ctx, payload)
+				_, err := client.Create(ctx, payload)
 				Expect(err).NotTo(HaveOccurred())
 			})
 
@@ -43,7 +47,7 @@ var _ = Describe("transaction management", func() {
 		When("a signal to create a transaction with positive amount is received", func() {
 			BeforeEach(func(ctx context.Context) {
 				payload.Amount = "10.01"
-				err := client.Create(ctx, payload)
+				_, err := client.Create(ctx, payload)
 				Expect(err).NotTo(HaveOccurred())
 			})
 
@@ -53,7 +57,8 @@ var _ = Describe("transaction management", func() {
 				Expect(transaction).ToNot(BeNil())
 				Expect(err).NotTo(HaveOccurred())
 
-				Expect(transaction.ID).To(Equal(payload.TransactionID))
+				Expect(transaction.ExternalID).To(Equal(payload.TransactionID))
+				Expect(transaction.WalletID.String()).To(Equal(payload.WalletID))
 				Expect(transaction.Amount.Cents).To(Equal(1001))
 				Expect(transaction.Status).To(Equal(entities.New))
 				Expect(transaction.Action).To(Equal(payload.State))
@@ -61,17 +66,43 @@ var _ = Describe("transaction management", func() {
 			})
 		})
 
+		When("a transaction is created within a trace", func() {
+			var spanContext trace.SpanContext
+
+			BeforeEach(func(ctx context.Context) {
+				spanContext = trace.NewSpanContext(trace.SpanContextConfig{
+					TraceID:    trace.TraceID{0x4b, 0xf9, 0x2f, 0x35, 0x77, 0xb3, 0x4d, 0xa6, 0xa3, 0xce, 0x92, 0x9d, 0x0e, 0x0e, 0x47, 0x36},
+					SpanID:     trace.SpanID{0x00, 0xf0, 0x67, 0xaa, 0x0b, 0xa9, 0x02, 0xb7},
+					TraceFlags: trace.FlagsSampled,
+				})
+
+				payload.Amount = "10.01"
+				_, err := client.Create(trace.ContextWithSpanContext(ctx, spanContext), payload)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("should store the trace context with the transaction", func() {
+				transaction, err := repo.GetNextTransaction()
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(transaction.TraceParent).ToNot(BeNil())
+				Expect(*transaction.TraceParent).To(Equal("00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"))
+			})
+		})
+
 		When("a signal to create a lost transaction with positive amount is received", func() {
 			var err error
 			BeforeEach(func(ctx context.Context) {
 				payload.Amount = "10.01"
 				payload.State = "lost"
-				err = client.Create(ctx, payload)
+				_, err = client.Create(ctx, payload)
 
 			})
 
-			It("error should return", func() {
-				Expect(err).To(HaveOccurred())
+			It("state amount mismatch error should return", func() {
+				var serviceErr *goa.ServiceError
+				Expect(errors.As(err, &serviceErr)).To(BeTrue())
+				Expect(serviceErr.Name).To(Equal("state_amount_mismatch"))
 			})
 		})
 
@@ -79,7 +110,7 @@ var _ = Describe("transaction management", func() {
 			BeforeEach(func(ctx context.Context) {
 				payload.Amount = "-10.01"
 				payload.State = "lost"
-				err := client.Create(ctx, payload)
+				_, err := client.Create(ctx, payload)
 				Expect(err).NotTo(HaveOccurred())
 			})
 
@@ -89,7 +120,8 @@ var _ = Describe("transaction management", func() {
 				Expect(transaction).ToNot(BeNil())
 				Expect(err).NotTo(HaveOccurred())
 
-				Expect(transaction.ID).To(Equal(payload.TransactionID))
+				Expect(transaction.ExternalID).To(Equal(payload.TransactionID))
+				Expect(transaction.WalletID.String()).To(Equal(payload.WalletID))
 				Expect(transaction.Amount.Cents).To(Equal(-1001))
 				Expect(transaction.Status).To(Equal(entities.New))
 				Expect(transaction.Action).To(Equal(payload.State))
@@ -102,12 +134,156 @@ var _ = Describe("transaction management", func() {
 			BeforeEach(func(ctx context.Context) {
 				payload.Amount = "-10.01"
 				payload.State = "win"
-				err = client.Create(ctx, payload)
+				_, err = client.Create(ctx, payload)
 
 			})
 
-			It("error should return", func() {
-				Expect(err).To(HaveOccurred())
+			It("state amount mismatch error should return", func() {
+				var serviceErr *goa.ServiceError
+				Expect(errors.As(err, &serviceErr)).To(BeTrue())
+				Expect(serviceErr.Name).To(Equal("state_amount_mismatch"))
+			})
+		})
+	})
+
+	Context("amount cannot be converted to cents exactly", func() {
+		BeforeEach(func() {
+			payload = &transaction.CreatePayload{
+				State:         entities.Win,
+				TransactionID: uuid.New().String(),
+				WalletID:      uuid.New().String(),
+				SourceType:    entities.Game,
+			}
+
+			repo = repositories.NewTransactionRepository(DB)
+		})
+
+		DescribeTable("invalid amount error should be returned and transaction should be ignored",
+			func(ctx context.Context, amount string) {
+				payload.Amount = amount
+				_, err := client.Create(ctx, payload)
+
+				var serviceErr *goa.ServiceError
+				Expect(errors.As(err, &serviceErr)).To(BeTrue())
+				Expect(serviceErr.Name).To(Equal("invalid_amount"))
+
+				transaction, err := repo.GetNextTransaction()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(transaction).To(BeNil())
+			},
+			Entry("too many fraction digits", "0.285"),
+			Entry("exponent", "1e3"),
+			Entry("not a number", "NaN"),
+			Entry("infinity", "Inf"),
+			Entry("empty fraction", "10."),
+			Entry("overflow", "99999999999999999999"),
+		)
+
+		When("a signal to create a transaction with one fraction digit is received", func() {
+			BeforeEach(func(ctx context.Context) {
+				payload.Amount = "10.1"
+				_, err := client.Create(ctx, payload)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("should save exact amount", func() {
+				transaction, err := repo.GetNextTransaction()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(transaction.Amount.Cents).To(Equal(1010))
+			})
+		})
+	})
+
+	Context("amount is in a currency other than EUR", func() {
+		BeforeEach(func() {
+			payload = &transaction.CreatePayload{
+				State:         entities.Win,
+				TransactionID: uuid.New().String(),
+				WalletID:      uuid.New().String(),
+				SourceType:    entities.Game,
+			}
+
+			repo = repositories.NewTransactionRepository(DB)
+		})
+
+		DescribeTable("amount should be saved in minor units of the currency",
+			func(ctx context.Context, currency string, amount string, minorUnits int) {
+				payload.Currency = currency
+				payload.Amount = amount
+				_, err := client.Create(ctx, payload)
+				Expect(err).NotTo(HaveOccurred())
+
+				transaction, err := repo.GetNextTransaction()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(transaction.Amount.Cents).To(Equal(minorUnits))
+				Expect(transaction.Currency).To(Equal(vo.Currency(currency)))
+			},
+			Entry("yen without fraction digits", "JPY", "1050", 1050),
+			Entry("dinar with three fraction digits", "BHD", "10.125", 10125),
+		)
+
+		DescribeTable("invalid amount error should be returned when precision exceeds the currency one",
+			func(ctx context.Context, currency string, amount string) {
+				payload.Currency = currency
+				payload.Amount = amount
+				_, err := client.Create(ctx, payload)
+
+				var serviceErr *goa.ServiceError
+				Expect(errors.As(err, &serviceErr)).To(BeTrue())
+				Expect(serviceErr.Name).To(Equal("invalid_amount"))
+			},
+			Entry("yen with fraction digits", "JPY", "10.5"),
+			Entry("dinar with four fraction digits", "BHD", "10.1255"),
+		)
+
+		When("a signal to create a transaction with unsupported currency is received", func() {
+			var err error
+			BeforeEach(func(ctx context.Context) {
+				payload.Currency = "XXX"
+				payload.Amount = "10"
+				_, err = client.Create(ctx, payload)
+			})
+
+			It("unsupported currency error should be returned", func() {
+				var serviceErr *goa.ServiceError
+				Expect(errors.As(err, &serviceErr)).To(BeTrue())
+				Expect(serviceErr.Name).To(Equal("unsupported_currency"))
+			})
+		})
+	})
+
+	Context("a transaction in EUR exists in the wallet", func() {
+		BeforeEach(func() {
+			payload = &transaction.CreatePayload{
+				State:         entities.Win,
+				Amount:        "10",
+				Currency:      "USD",
+				TransactionID: uuid.New().String(),
+				WalletID:      uuid.New().String(),
+				SourceType:    entities.Game,
+			}
+
+			repo = repositories.NewTransactionRepository(DB)
+
+			existedTransaction := entities.NewTransaction(uuid.New().String(), uuid.MustParse(payload.WalletID), vo.NewAmount(10), vo.DefaultCurrency, entities.Win, entities.Game)
+			err := repo.Save(existedTransaction)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		When("a signal to create a transaction in USD is received", func() {
+			var err error
+			BeforeEach(func(ctx context.Context) {
+				_, err = client.Create(ctx, payload)
+			})
+
+			It("currency mismatch error should be returned and transaction should be ignored", func() {
+				var serviceErr *goa.ServiceError
+				Expect(errors.As(err, &serviceErr)).To(BeTrue())
+				Expect(serviceErr.Name).To(Equal("currency_mismatch"))
+
+				transactions, err := repo.GetAllTransactions()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(len(transactions)).To(Equal(1))
 			})
 		})
 	})
@@ -119,27 +295,72 @@ var _ = Describe("transaction management", func() {
 				State:         entities.Win,
 				Amount:        "10.01",
 				TransactionID: uuid.New().String(),
+				WalletID:      uuid.New().String(),
 				SourceType:    entities.Game,
 			}
 
 			repo = repositories.NewTransactionRepository(DB)
 
-			existedTransaction = entities.NewTransaction(uuid.New().String(), vo.NewAmount(10), entities.Win, entities.Game)
+			existedTransaction = entities.NewTransaction(uuid.New().String(), uuid.MustParse(payload.WalletID), vo.NewAmount(10), vo.DefaultCurrency, entities.Win, entities.Game)
 			err := repo.Save(existedTransaction)
 			Expect(err).NotTo(HaveOccurred())
 		})
 
-		When("a signals to create a transaction with same ID is received", func() {
+		When("a signals to create a transaction with same ID and payload is received", func() {
+			var res *transaction.CreateResult
+			BeforeEach(func(ctx context.Context) {
+				payload.TransactionID = existedTransaction.ExternalID
+				payload.Amount = "0.10"
+
+				var err error
+				res, err = client.Create(ctx, payload)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("should only save the first transaction and report the replay", func() {
+				Expect(res.Outcome).To(Equal("replayed"))
+
+				transactions, err := repo.GetAllTransactions()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(len(transactions)).To(Equal(1))
+			})
+		})
+
+		When("a signals to create a transaction with same ID from another source type is received", func() {
 			BeforeEach(func(ctx context.Context) {
-				payload.TransactionID = existedTransaction.ID
-				err := client.Create(ctx, payload)
+				payload.TransactionID = existedTransaction.ExternalID
+				payload.SourceType = entities.Payment
+				_, err := client.Create(ctx, payload)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("should save both transactions", func() {
+				transactions, err := repo.GetAllTransactions()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(len(transactions)).To(Equal(2))
+
+				transaction, err := repo.FindByExternalID(entities.Payment, existedTransaction.ExternalID)
 				Expect(err).NotTo(HaveOccurred())
+				Expect(transaction.ID).NotTo(Equal(existedTransaction.ID))
 			})
+		})
+
+		When("a signals to create a transaction with same ID and different payload is received", func() {
+			var err error
+			BeforeEach(func(ctx context.Context) {
+				payload.TransactionID = existedTransaction.ExternalID
+				_, err = client.Create(ctx, payload)
+			})
+
+			It("duplicate transaction error should be returned and the first transaction should be kept", func() {
+				var serviceErr *goa.ServiceError
+				Expect(errors.As(err, &serviceErr)).To(BeTrue())
+				Expect(serviceErr.Name).To(Equal("duplicate_transaction"))
 
-			It("should only save the first transaction and ignore subsequent ones", func() {
 				transactions, err := repo.GetAllTransactions()
 				Expect(err).NotTo(HaveOccurred())
 				Expect(len(transactions)).To(Equal(1))
+				Expect(transactions[0].Amount.Cents).To(Equal(10))
 			})
 		})
 	})
@@ -151,21 +372,23 @@ var _ = Describe("transaction management", func() {
 				State:         entities.Win,
 				Amount:        "10.01",
 				TransactionID: uuid.New().String(),
+				WalletID:      uuid.New().String(),
 				SourceType:    entities.Game,
 			}
 
 			repo = repositories.NewTransactionRepository(DB)
 
-			existedTransaction = entities.NewTransaction(uuid.New().String(), vo.NewAmount(10), entities.Win, entities.Game)
-			existedTransaction.MarkAsCancelled()
+			existedTransaction = entities.NewTransaction(uuid.New().String(), uuid.MustParse(payload.WalletID), vo.NewAmount(10), vo.DefaultCurrency, entities.Win, entities.Game)
+			existedTransaction.MarkAsCancelled(entities.InsufficientFunds)
 			err := repo.Save(existedTransaction)
 			Expect(err).NotTo(HaveOccurred())
 		})
 
-		When("a signals to create a transaction with same ID is received", func() {
+		When("a signals to create a transaction with same ID and payload is received", func() {
 			BeforeEach(func(ctx context.Context) {
-				payload.TransactionID = existedTransaction.ID
-				err := client.Create(ctx, payload)
+				payload.TransactionID = existedTransaction.ExternalID
+				payload.Amount = "0.10"
+				_, err := client.Create(ctx, payload)
 				Expect(err).NotTo(HaveOccurred())
 			})
 
@@ -0,0 +1,134 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	goa "goa.design/goa/v3/pkg"
+	"time"
+	"wallet/gen/transaction"
+	"wallet/transaction/internal/domain/entities"
+	"wallet/transaction/workers"
+)
+
+var _ = Describe("balance retrieving", func() {
+	var walletID uuid.UUID
+
+	BeforeEach(func() {
+		walletID = uuid.New()
+	})
+
+	Context("no transactions exist in the wallet", func() {
+		When("the balance is requested", func() {
+			var result *transaction.BalanceResult
+
+			BeforeEach(func(ctx context.Context) {
+				var err error
+				result, err = client.Balance(ctx, &transaction.BalancePayload{WalletID: walletID.String()})
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("should return zero balance", func() {
+				Expect(result.WalletID).To(Equal(walletID.String()))
+				Expect(result.Amount).To(Equal("0.00"))
+				Expect(result.Pending).To(Equal(0))
+			})
+		})
+	})
+
+	Context("an unprocessed transaction exists in the wallet", func() {
+		BeforeEach(func(ctx context.Context) {
+			_, err := client.Create(ctx, &transaction.CreatePayload{
+				State:         entities.Win,
+				Amount:        "10.15",
+				TransactionID: uuid.New().String(),
+				WalletID:      walletID.String(),
+				SourceType:    entities.Game,
+			})
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		When("the balance is requested", func() {
+			var result *transaction.BalanceResult
+
+			BeforeEach(func(ctx context.Context) {
+				var err error
+				result, err = client.Balance(ctx, &transaction.BalancePayload{WalletID: walletID.String()})
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("should not include the transaction into the balance", func() {
+				Expect(result.Amount).To(Equal("0.00"))
+				Expect(result.Pending).To(Equal(1))
+			})
+		})
+
+		When("the transaction is processed and the balance is requested", func() {
+			var result *transaction.BalanceResult
+
+			BeforeEach(func(ctx context.Context) {
+				err := workers.NewBalanceWorker(DB, uuid.New()).Execute()
+				Expect(err).NotTo(HaveOccurred())
+
+				result, err = client.Balance(ctx, &transaction.BalancePayload{WalletID: walletID.String()})
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("should include the transaction into the balance", func() {
+				Expect(result.Amount).To(Equal("10.15"))
+				Expect(result.Pending).To(Equal(0))
+			})
+		})
+
+		When("the transaction is processed and the balance before the processing is requested", func() {
+			var result *transaction.BalanceResult
+
+			BeforeEach(func(ctx context.Context) {
+				asOf := time.Now().Add(-time.Second).Format(time.RFC3339)
+				err := workers.NewBalanceWorker(DB, uuid.New()).Execute()
+				Expect(err).NotTo(HaveOccurred())
+
+				result, err = client.Balance(ctx, &transaction.BalancePayload{WalletID: walletID.String(), AsOf: &asOf})
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("should not include the transaction into the balance", func() {
+				Expect(result.Amount).To(Equal("0.00"))
+				Expect(result.AsOf).NotTo(BeNil())
+			})
+		})
+	})
+
+	Context("the request is malformed", func() {
+		When("the balance of a malformed wallet ID is requested", func() {
+			var err error
+
+			BeforeEach(func(ctx context.Context) {
+				_, err = client.Balance(ctx, &transaction.BalancePayload{WalletID: "not-a-uuid"})
+			})
+
+			It("should return invalid input error", func() {
+				var serviceErr *goa.ServiceError
+				Expect(errors.As(err, &serviceErr)).To(BeTrue())
+				Expect(serviceErr.Name).To(Equal("invalid_input"))
+			})
+		})
+
+		When("the balance as of a malformed date is requested", func() {
+			var err error
+
+			BeforeEach(func(ctx context.Context) {
+				asOf := "yesterday"
+				_, err = client.Balance(ctx, &transaction.BalancePayload{WalletID: walletID.String(), AsOf: &asOf})
+			})
+
+			It("should return invalid input error", func() {
+				var serviceErr *goa.ServiceError
+				Expect(errors.As(err, &serviceErr)).To(BeTrue())
+				Expect(serviceErr.Name).To(Equal("invalid_input"))
+			})
+		})
+	})
+})
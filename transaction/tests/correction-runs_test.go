@@ -0,0 +1,85 @@
+package tests
+
+import (
+	"context"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"wallet/gen/correction"
+	"wallet/gen/transaction"
+	"wallet/transaction/internal/domain/entities"
+	"wallet/transaction/internal/domain/repositories"
+	"wallet/transaction/workers"
+)
+
+var _ = Describe("correction history", func() {
+	var walletID string
+
+	BeforeEach(func(ctx context.Context) {
+		walletID = uuid.New().String()
+		repo := repositories.NewTransactionRepository(DB)
+
+		var ids []string
+		for i := 0; i < 3; i++ {
+			payload := &transaction.CreatePayload{
+				State:         entities.Win,
+				Amount:        "1.00",
+				TransactionID: uuid.New().String(),
+				WalletID:      walletID,
+				SourceType:    entities.Game,
+			}
+			_, err := client.Create(ctx, payload)
+			Expect(err).NotTo(HaveOccurred())
+
+			tx, err := repo.FindByExternalID(payload.SourceType, payload.TransactionID)
+			Expect(err).NotTo(HaveOccurred())
+			ids = append(ids, tx.ID.String())
+		}
+		Expect(workers.NewBalanceWorker(DB, uuid.New()).Execute()).To(Succeed())
+
+		for _, id := range ids {
+			_, err := correctionClient.Create(ctx, &correction.CreatePayload{
+				TransactionIds: []string{id},
+				Reason:         "duplicated payout",
+				RequestedBy:    "jane.doe",
+			})
+			Expect(err).NotTo(HaveOccurred())
+		}
+	})
+
+	When("the first page is requested", func() {
+		var page *correction.CorrectionRunList
+
+		BeforeEach(func(ctx context.Context) {
+			var err error
+			page, err = correctionClient.List(ctx, &correction.ListPayload{WalletID: &walletID, Limit: 2})
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should return the newest runs", func() {
+			Expect(page.Runs).To(HaveLen(2))
+			Expect(page.Runs[0].Kind).To(Equal(entities.Manual))
+			Expect(page.Runs[0].WalletID).To(Equal(walletID))
+			Expect(page.Runs[0].TransactionIds).To(HaveLen(1))
+			Expect(page.Runs[0].CompensationID).NotTo(BeNil())
+			Expect(page.Runs[0].Delta).To(Equal("-1.00"))
+			Expect(page.Runs[0].FinishedAt).NotTo(BeNil())
+			Expect(page.NextCursor).NotTo(BeNil())
+		})
+
+		When("the next page is requested", func() {
+			var nextPage *correction.CorrectionRunList
+
+			BeforeEach(func(ctx context.Context) {
+				var err error
+				nextPage, err = correctionClient.List(ctx, &correction.ListPayload{WalletID: &walletID, Cursor: page.NextCursor, Limit: 2})
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("should return the remaining run", func() {
+				Expect(nextPage.Runs).To(HaveLen(1))
+				Expect(nextPage.NextCursor).To(BeNil())
+			})
+		})
+	})
+})
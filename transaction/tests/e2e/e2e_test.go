@@ -19,14 +19,14 @@ var _ = Describe("transaction сreation", func() {
 
 			BeforeEach(func() {
 				transactionId = uuid.New().String()
-				responseCode = createTx(transactionId, 10, entities.Win)
+				responseCode = createTx(transactionId, uuid.New(), 10, entities.Win)
 				repo = repositories.NewTransactionRepository(DB)
 			})
 
 			It("should successfully save the transaction", func() {
 				transaction, err := repo.GetNextTransaction()
 
-				Expect(transaction.ID).To(Equal(transactionId))
+				Expect(transaction.ExternalID).To(Equal(transactionId))
 				Expect(err).NotTo(HaveOccurred())
 			})
 
@@ -34,5 +34,50 @@ var _ = Describe("transaction сreation", func() {
 				Expect(responseCode).To(Equal(202))
 			})
 		})
+
+		When("a signal to create a win transaction with negative amount is received", func() {
+			var (
+				responseCode int
+				body         errorBody
+			)
+
+			BeforeEach(func() {
+				responseCode, body = createTxWithRequestID(uuid.New().String(), uuid.New(), -10, entities.Win, "test-request-id")
+			})
+
+			It("bad request http code should be returned", func() {
+				Expect(responseCode).To(Equal(400))
+			})
+
+			It("error code and request ID should be returned", func() {
+				Expect(body.Name).To(Equal("state_amount_mismatch"))
+				Expect(body.ID).To(Equal("test-request-id"))
+			})
+		})
+	})
+})
+
+var _ = Describe("transaction lookup", func() {
+	Context("the transaction does not exist", func() {
+		When("the transaction is requested", func() {
+			It("not found http code should be returned", func() {
+				Expect(getTx(uuid.New().String())).To(Equal(404))
+			})
+		})
+	})
+
+	Context("the transaction exists", func() {
+		var transactionId string
+
+		BeforeEach(func() {
+			transactionId = uuid.New().String()
+			Expect(createTx(transactionId, uuid.New(), 10, entities.Win)).To(Equal(202))
+		})
+
+		When("the transaction is requested", func() {
+			It("correct http code should be returned", func() {
+				Expect(getTx(transactionId)).To(Equal(200))
+			})
+		})
 	})
 })
@@ -4,19 +4,21 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"github.com/google/uuid"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"net/http"
 	"wallet/transaction/internal/domain/entities"
 )
 
-func createTx(txID string, amount float64, action string) int {
+func createTx(txID string, walletID uuid.UUID, amount float64, action string) int {
 	GinkgoHelper()
 
 	payload := map[string]string{
 		"state":         action,
 		"amount":        fmt.Sprintf("%.2f", amount),
 		"transactionId": txID,
+		"walletId":      walletID.String(),
 	}
 
 	jsonPayload, err := json.Marshal(payload)
@@ -38,3 +40,57 @@ func createTx(txID string, amount float64, action string) int {
 
 	return resp.StatusCode
 }
+
+func getTx(txID string) int {
+	GinkgoHelper()
+
+	req, err := http.NewRequest("GET", "http://0.0.0.0:8081/transaction/"+txID, nil)
+	Expect(err).NotTo(HaveOccurred())
+
+	req.Header.Set("Source-Type", entities.Game)
+
+	client := &http.Client{}
+	resp, err := client.Do(req)
+	Expect(err).NotTo(HaveOccurred())
+
+	defer resp.Body.Close()
+
+	return resp.StatusCode
+}
+
+type errorBody struct {
+	Name string `json:"name"`
+	ID   string `json:"id"`
+}
+
+func createTxWithRequestID(txID string, walletID uuid.UUID, amount float64, action string, requestID string) (int, errorBody) {
+	GinkgoHelper()
+
+	payload := map[string]string{
+		"state":         action,
+		"amount":        fmt.Sprintf("%.2f", amount),
+		"transactionId": txID,
+		"walletId":      walletID.String(),
+	}
+
+	jsonPayload, err := json.Marshal(payload)
+	Expect(err).NotTo(HaveOccurred())
+
+	req, err := http.NewRequest("POST", "http://0.0.0.0:8081/transaction", bytes.NewBuffer(jsonPayload))
+	Expect(err).NotTo(HaveOccurred())
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Source-Type", entities.Game)
+	req.Header.Set("X-Request-Id", requestID)
+
+	client := &http.Client{}
+	resp, err := client.Do(req)
+	Expect(err).NotTo(HaveOccurred())
+
+	defer resp.Body.Close()
+
+	var body errorBody
+	_ = json.NewDecoder(resp.Body).Decode(&body)
+
+	return resp.StatusCode, body
+}
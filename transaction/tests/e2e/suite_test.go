@@ -10,6 +10,7 @@ import (
 	"sync"
 	"testing"
 	"wallet/config"
+	"wallet/gen/correction"
 	"wallet/gen/transaction"
 	"wallet/transaction/interfaces"
 	"wallet/transaction/interfaces/http"
@@ -46,6 +47,7 @@ func runServer() {
 
 	txSvc := interfaces.NewTxController(DB)
 	txEndpoints := transaction.NewEndpoints(txSvc)
+	correctionEndpoints := correction.NewEndpoints(interfaces.NewCorrectionController(DB))
 	u, err := url.Parse(addr)
 	if err != nil {
 		panic("failed to parse address")
@@ -53,7 +55,7 @@ func runServer() {
 	var wg sync.WaitGroup
 	errc := make(chan error)
 
-	http.HandleHTTPServer(ctx, u, txEndpoints, &wg, errc, false)
+	http.HandleHTTPServer(ctx, u, txEndpoints, correctionEndpoints, &wg, errc, false)
 
 	DeferCleanup(func() {
 		cancel()
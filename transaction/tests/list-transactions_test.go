@@ -0,0 +1,131 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	goa "goa.design/goa/v3/pkg"
+	"wallet/gen/transaction"
+	"wallet/transaction/internal/domain/entities"
+)
+
+var _ = Describe("transaction history", func() {
+	var (
+		walletID       string
+		transactionIDs []string
+	)
+
+	BeforeEach(func(ctx context.Context) {
+		walletID = uuid.New().String()
+		transactionIDs = nil
+
+		for _, amount := range []string{"10.00", "-5.00", "1.00"} {
+			state := entities.Win
+			if amount[0] == '-' {
+				state = entities.Lost
+			}
+
+			payload := &transaction.CreatePayload{
+				State:         state,
+				Amount:        amount,
+				TransactionID: uuid.New().String(),
+				WalletID:      walletID,
+				SourceType:    entities.Game,
+			}
+			_, err := client.Create(ctx, payload)
+			Expect(err).NotTo(HaveOccurred())
+			transactionIDs = append(transactionIDs, payload.TransactionID)
+		}
+
+		_, err := client.Create(ctx, &transaction.CreatePayload{
+			State:         entities.Win,
+			Amount:        "10.00",
+			TransactionID: uuid.New().String(),
+			WalletID:      uuid.New().String(),
+			SourceType:    entities.Payment,
+		})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	When("the first page of the wallet history is requested", func() {
+		var page *transaction.TransactionList
+
+		BeforeEach(func(ctx context.Context) {
+			var err error
+			page, err = client.List(ctx, &transaction.ListPayload{WalletID: &walletID, Limit: 2})
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should return the newest transactions of the wallet", func() {
+			Expect(page.Transactions).To(HaveLen(2))
+			Expect(page.Transactions[0].TransactionID).To(Equal(transactionIDs[2]))
+			Expect(page.Transactions[1].TransactionID).To(Equal(transactionIDs[1]))
+			Expect(page.NextCursor).ToNot(BeNil())
+		})
+
+		When("the next page is requested", func() {
+			var nextPage *transaction.TransactionList
+
+			BeforeEach(func(ctx context.Context) {
+				var err error
+				nextPage, err = client.List(ctx, &transaction.ListPayload{WalletID: &walletID, Cursor: page.NextCursor, Limit: 2})
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("should return the rest of the transactions", func() {
+				Expect(nextPage.Transactions).To(HaveLen(1))
+				Expect(nextPage.Transactions[0].TransactionID).To(Equal(transactionIDs[0]))
+				Expect(nextPage.NextCursor).To(BeNil())
+			})
+		})
+	})
+
+	When("transactions are filtered", func() {
+		var page *transaction.TransactionList
+
+		BeforeEach(func(ctx context.Context) {
+			action := entities.Lost
+			var err error
+			page, err = client.List(ctx, &transaction.ListPayload{WalletID: &walletID, Action: &action, Limit: 50})
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should return only matching transactions", func() {
+			Expect(page.Transactions).To(HaveLen(1))
+			Expect(page.Transactions[0].TransactionID).To(Equal(transactionIDs[1]))
+		})
+	})
+
+	When("transactions of a source type are requested", func() {
+		var page *transaction.TransactionList
+
+		BeforeEach(func(ctx context.Context) {
+			sourceType := entities.Payment
+			var err error
+			page, err = client.List(ctx, &transaction.ListPayload{SourceType: &sourceType, Limit: 50})
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should return transactions of every wallet", func() {
+			Expect(page.Transactions).To(HaveLen(1))
+			Expect(page.Transactions[0].WalletID).ToNot(Equal(walletID))
+		})
+	})
+
+	When("an invalid cursor is sent", func() {
+		var err error
+
+		BeforeEach(func(ctx context.Context) {
+			cursor := "not a cursor"
+			_, err = client.List(ctx, &transaction.ListPayload{Cursor: &cursor, Limit: 50})
+		})
+
+		It("invalid cursor error should be returned", func() {
+			var serviceErr *goa.ServiceError
+			Expect(errors.As(err, &serviceErr)).To(BeTrue())
+			Expect(serviceErr.Name).To(Equal("invalid_cursor"))
+		})
+	})
+})
@@ -0,0 +1,242 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	goa "goa.design/goa/v3/pkg"
+	"wallet/gen/correction"
+	"wallet/gen/transaction"
+	"wallet/transaction/internal/domain/entities"
+	"wallet/transaction/internal/domain/repositories"
+	"wallet/transaction/internal/domain/vo"
+	"wallet/transaction/workers"
+)
+
+var _ = Describe("manual correction", func() {
+	var (
+		walletID uuid.UUID
+		repo     *repositories.TransactionRepository
+	)
+
+	createTransaction := func(ctx context.Context, walletID uuid.UUID, amount string) *entities.Transaction {
+		GinkgoHelper()
+
+		payload := &transaction.CreatePayload{
+			State:         entities.Win,
+			Amount:        amount,
+			TransactionID: uuid.New().String(),
+			WalletID:      walletID.String(),
+			SourceType:    entities.Game,
+		}
+		_, err := client.Create(ctx, payload)
+		Expect(err).NotTo(HaveOccurred())
+
+		tx, err := repo.FindByExternalID(payload.SourceType, payload.TransactionID)
+		Expect(err).NotTo(HaveOccurred())
+
+		return tx
+	}
+
+	BeforeEach(func() {
+		walletID = uuid.New()
+		repo = repositories.NewTransactionRepository(DB)
+	})
+
+	Context("done transactions exist in the wallet", func() {
+		var first, second *entities.Transaction
+
+		BeforeEach(func(ctx context.Context) {
+			first = createTransaction(ctx, walletID, "10.00")
+			second = createTransaction(ctx, walletID, "5.50")
+			Expect(workers.NewBalanceWorker(DB, uuid.New()).Execute()).To(Succeed())
+		})
+
+		When("a correction of the transactions is requested", func() {
+			var result *correction.ManualCorrection
+
+			BeforeEach(func(ctx context.Context) {
+				var err error
+				result, err = correctionClient.Create(ctx, &correction.CreatePayload{
+					TransactionIds: []string{first.ID.String(), second.ID.String()},
+					Reason:         "duplicated payout",
+					RequestedBy:    "jane.doe",
+				})
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("should record who requested the correction and why", func() {
+				Expect(result.WalletID).To(Equal(walletID.String()))
+				Expect(result.TransactionIds).To(ConsistOf(first.ID.String(), second.ID.String()))
+				Expect(result.Reason).To(Equal("duplicated payout"))
+				Expect(result.RequestedBy).To(Equal("jane.doe"))
+				Expect(result.CompensationID).NotTo(BeNil())
+			})
+
+			It("should cancel the transactions", func() {
+				for _, tx := range []*entities.Transaction{first, second} {
+					cancelled, err := repo.FindByID(tx.ID)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(cancelled.Status).To(Equal(entities.Cancelled))
+					Expect(*cancelled.CancelReason).To(Equal(entities.Reversed))
+				}
+			})
+
+			It("should post a single compensating internal transaction", func() {
+				compensation, err := repo.FindByID(uuid.MustParse(*result.CompensationID))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(compensation.SourceType).To(Equal(entities.Internal))
+				Expect(compensation.Action).To(Equal(entities.Lost))
+				Expect(compensation.Amount.Cents).To(Equal(-1550))
+			})
+
+			It("should link the compensating transaction to the cancelled ones", func(ctx context.Context) {
+				compensation, err := repo.FindByID(uuid.MustParse(*result.CompensationID))
+				Expect(err).NotTo(HaveOccurred())
+
+				shown, err := client.Show(ctx, &transaction.ShowPayload{
+					SourceType:    entities.Internal,
+					TransactionID: compensation.ExternalID,
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(shown.Reverses).To(ConsistOf(first.ID.String(), second.ID.String()))
+				Expect(shown.ReversedBy).To(BeNil())
+
+				shown, err = client.Show(ctx, &transaction.ShowPayload{
+					SourceType:    first.SourceType,
+					TransactionID: first.ExternalID,
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(shown.ReversedBy).To(HaveValue(Equal(compensation.ID.String())))
+				Expect(shown.Reverses).To(BeEmpty())
+			})
+
+			When("the compensating transaction is processed", func() {
+				BeforeEach(func() {
+					Expect(workers.NewBalanceWorker(DB, uuid.New()).Execute()).To(Succeed())
+				})
+
+				It("should revert the balance", func(ctx context.Context) {
+					balance, err := client.Balance(ctx, &transaction.BalancePayload{WalletID: walletID.String()})
+					Expect(err).NotTo(HaveOccurred())
+					Expect(balance.Amount).To(Equal("0.00"))
+				})
+			})
+		})
+
+		When("a correction of an unknown transaction is requested", func() {
+			var err error
+
+			BeforeEach(func(ctx context.Context) {
+				_, err = correctionClient.Create(ctx, &correction.CreatePayload{
+					TransactionIds: []string{first.ID.String(), uuid.New().String()},
+					Reason:         "duplicated payout",
+					RequestedBy:    "jane.doe",
+				})
+			})
+
+			It("not found error should be returned and transactions should be kept", func() {
+				var serviceErr *goa.ServiceError
+				Expect(errors.As(err, &serviceErr)).To(BeTrue())
+				Expect(serviceErr.Name).To(Equal("not_found"))
+
+				tx, err := repo.FindByID(first.ID)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(tx.Status).To(Equal(entities.Done))
+			})
+		})
+
+		When("a correction of transactions of different wallets is requested", func() {
+			var err error
+
+			BeforeEach(func(ctx context.Context) {
+				another := createTransaction(ctx, uuid.New(), "1.00")
+				Expect(workers.NewBalanceWorker(DB, uuid.New()).Execute()).To(Succeed())
+
+				_, err = correctionClient.Create(ctx, &correction.CreatePayload{
+					TransactionIds: []string{first.ID.String(), another.ID.String()},
+					Reason:         "duplicated payout",
+					RequestedBy:    "jane.doe",
+				})
+			})
+
+			It("wallet mismatch error should be returned", func() {
+				var serviceErr *goa.ServiceError
+				Expect(errors.As(err, &serviceErr)).To(BeTrue())
+				Expect(serviceErr.Name).To(Equal("wallet_mismatch"))
+			})
+		})
+
+		When("a correction of transactions in different currencies is requested", func() {
+			var err error
+
+			BeforeEach(func(ctx context.Context) {
+				dollars := entities.NewTransaction(uuid.New().String(), walletID, vo.NewAmount(100), vo.Currency("USD"), entities.Win, entities.Game)
+				dollars.MarkAsDone()
+				Expect(repo.Save(dollars)).To(Succeed())
+
+				_, err = correctionClient.Create(ctx, &correction.CreatePayload{
+					TransactionIds: []string{first.ID.String(), dollars.ID.String()},
+					Reason:         "duplicated payout",
+					RequestedBy:    "jane.doe",
+				})
+			})
+
+			It("mixed currencies error should be returned and transactions should be kept", func() {
+				var serviceErr *goa.ServiceError
+				Expect(errors.As(err, &serviceErr)).To(BeTrue())
+				Expect(serviceErr.Name).To(Equal("mixed_currencies"))
+
+				tx, err := repo.FindByID(first.ID)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(tx.Status).To(Equal(entities.Done))
+			})
+		})
+	})
+
+	Context("an unprocessed transaction exists in the wallet", func() {
+		var tx *entities.Transaction
+
+		BeforeEach(func(ctx context.Context) {
+			tx = createTransaction(ctx, walletID, "10.00")
+		})
+
+		When("a correction of the transaction is requested", func() {
+			var err error
+
+			BeforeEach(func(ctx context.Context) {
+				_, err = correctionClient.Create(ctx, &correction.CreatePayload{
+					TransactionIds: []string{tx.ID.String()},
+					Reason:         "duplicated payout",
+					RequestedBy:    "jane.doe",
+				})
+			})
+
+			It("not done error should be returned", func() {
+				var serviceErr *goa.ServiceError
+				Expect(errors.As(err, &serviceErr)).To(BeTrue())
+				Expect(serviceErr.Name).To(Equal("not_done"))
+			})
+		})
+	})
+
+	When("a correction of a malformed transaction ID is requested", func() {
+		var err error
+
+		BeforeEach(func(ctx context.Context) {
+			_, err = correctionClient.Create(ctx, &correction.CreatePayload{
+				TransactionIds: []string{"not-a-uuid"},
+				Reason:         "duplicated payout",
+				RequestedBy:    "jane.doe",
+			})
+		})
+
+		It("invalid input error should be returned", func() {
+			var serviceErr *goa.ServiceError
+			Expect(errors.As(err, &serviceErr)).To(BeTrue())
+			Expect(serviceErr.Name).To(Equal("invalid_input"))
+		})
+	})
+})
@@ -0,0 +1,111 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	goa "goa.design/goa/v3/pkg"
+	"time"
+	"wallet/gen/transaction"
+	"wallet/transaction/internal/domain/entities"
+	"wallet/transaction/workers"
+)
+
+var _ = Describe("transaction lookup", func() {
+	var payload *transaction.CreatePayload
+
+	BeforeEach(func() {
+		payload = &transaction.CreatePayload{
+			State:         entities.Lost,
+			Amount:        "-10.01",
+			TransactionID: uuid.New().String(),
+			WalletID:      uuid.New().String(),
+			SourceType:    entities.Game,
+		}
+	})
+
+	Context("the transaction does not exist", func() {
+		When("the transaction is requested", func() {
+			var err error
+
+			BeforeEach(func(ctx context.Context) {
+				_, err = client.Show(ctx, &transaction.ShowPayload{TransactionID: payload.TransactionID, SourceType: payload.SourceType})
+			})
+
+			It("not found error should be returned", func() {
+				var serviceErr *goa.ServiceError
+				Expect(errors.As(err, &serviceErr)).To(BeTrue())
+				Expect(serviceErr.Name).To(Equal("not_found"))
+			})
+		})
+	})
+
+	Context("the transaction was created", func() {
+		BeforeEach(func(ctx context.Context) {
+			_, err := client.Create(ctx, payload)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		When("the transaction is requested", func() {
+			var result *transaction.Transaction
+
+			BeforeEach(func(ctx context.Context) {
+				var err error
+				result, err = client.Show(ctx, &transaction.ShowPayload{TransactionID: payload.TransactionID, SourceType: payload.SourceType})
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("should return the transaction data", func() {
+				Expect(uuid.Validate(result.ID)).To(Succeed())
+				Expect(result.TransactionID).To(Equal(payload.TransactionID))
+				Expect(result.WalletID).To(Equal(payload.WalletID))
+				Expect(result.Status).To(Equal(entities.New))
+				Expect(result.Amount).To(Equal("-10.01"))
+				Expect(result.Action).To(Equal(entities.Lost))
+				Expect(result.SourceType).To(Equal(entities.Game))
+				Expect(result.ProcessedAt).To(BeNil())
+			})
+		})
+
+		When("the transaction is requested with another source type", func() {
+			var err error
+
+			BeforeEach(func(ctx context.Context) {
+				_, err = client.Show(ctx, &transaction.ShowPayload{TransactionID: payload.TransactionID, SourceType: entities.Payment})
+			})
+
+			It("not found error should be returned", func() {
+				var serviceErr *goa.ServiceError
+				Expect(errors.As(err, &serviceErr)).To(BeTrue())
+				Expect(serviceErr.Name).To(Equal("not_found"))
+			})
+		})
+
+		When("the transaction is processed and requested", func() {
+			var result *transaction.Transaction
+
+			BeforeEach(func(ctx context.Context) {
+				err := workers.NewBalanceWorker(DB, uuid.New()).Execute()
+				Expect(err).NotTo(HaveOccurred())
+
+				result, err = client.Show(ctx, &transaction.ShowPayload{TransactionID: payload.TransactionID, SourceType: payload.SourceType})
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("should be cancelled because of insufficient funds", func() {
+				Expect(result.Status).To(Equal(entities.Cancelled))
+				Expect(*result.CancelReason).To(Equal(entities.InsufficientFunds))
+			})
+
+			It("should return the processing time", func() {
+				Expect(result.ProcessedAt).ToNot(BeNil())
+
+				processedAt, err := time.Parse(time.RFC3339Nano, *result.ProcessedAt)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(processedAt).To(BeTemporally("~", time.Now(), time.Minute))
+			})
+		})
+	})
+})
@@ -19,10 +19,12 @@ var _ = Describe("transaction management", func() {
 	var balanceRepo *repositories.BalanceRepository
 	var expectedBalance int64
 	var numOfTransactions = 1000
+	var walletID uuid.UUID
 
 	Context("asynchronous request processing", func() {
 		BeforeEach(func() {
-			payloads, expectedBalance = generatePayloads(numOfTransactions)
+			walletID = uuid.New()
+			payloads, expectedBalance = generatePayloads(walletID, numOfTransactions)
 			repo = repositories.NewTransactionRepository(DB)
 			balanceRepo = repositories.NewBalanceRepository(DB)
 		})
@@ -30,7 +32,7 @@ var _ = Describe("transaction management", func() {
 		When("all payloads are sends", func() {
 			BeforeEach(func(ctx context.Context) {
 				for _, payload := range payloads {
-					err := client.Create(ctx, payload)
+					_, err := client.Create(ctx, payload)
 					Expect(err).NotTo(HaveOccurred())
 				}
 			})
@@ -43,7 +45,7 @@ var _ = Describe("transaction management", func() {
 
 			When("transactions are processed", func() {
 				BeforeEach(func(ctx context.Context) {
-					worker := workers.NewBalanceWorker(DB)
+					worker := workers.NewBalanceWorker(DB, uuid.New())
 					for i := 0; i < numOfTransactions; i++ {
 						err := worker.Execute()
 						Expect(err).NotTo(HaveOccurred())
@@ -51,7 +53,7 @@ var _ = Describe("transaction management", func() {
 				})
 
 				It("balance are correct2", func() {
-					balance, err := balanceRepo.Get()
+					balance, err := balanceRepo.Get(walletID)
 					Expect(err).NotTo(HaveOccurred())
 
 					Expect(balance.Value.Cents).To(Equal(expectedBalance * 100))
@@ -61,7 +63,7 @@ var _ = Describe("transaction management", func() {
 	})
 })
 
-func generatePayloads(limit int) ([]*transaction.CreatePayload, int64) {
+func generatePayloads(walletID uuid.UUID, limit int) ([]*transaction.CreatePayload, int64) {
 	payloads := make([]*transaction.CreatePayload, 0, limit)
 	expectedBalance := int64(0)
 	rand.Seed(time.Now().UnixNano())
@@ -86,6 +88,7 @@ func generatePayloads(limit int) ([]*transaction.CreatePayload, int64) {
 			State:         state,
 			Amount:        amount,
 			TransactionID: uuid.New().String(),
+			WalletID:      walletID.String(),
 			SourceType:    entities.Game,
 		})
 	}
@@ -7,16 +7,19 @@ import (
 	"gorm.io/gorm"
 	"testing"
 	"wallet/config"
+	"wallet/gen/correction"
 	"wallet/gen/transaction"
 	"wallet/transaction/interfaces"
 	"wallet/transaction/internal/infrastructure/db"
 )
 
 var client *transaction.Client
+var correctionClient *correction.Client
 var DB *gorm.DB
 var _ = BeforeSuite(func(ctx context.Context) {
 	DB = connectToTestDB(ctx)
 	client = createTestClient()
+	correctionClient = createTestCorrectionClient()
 })
 
 var _ = BeforeEach(func() {
@@ -26,7 +29,16 @@ var _ = BeforeEach(func() {
 func createTestClient() *transaction.Client {
 	endpoint := transaction.NewCreateEndpoint(interfaces.NewTxController(DB))
 	healthcheck := transaction.NewHealthcheckEndpoint(interfaces.NewTxController(DB))
-	return transaction.NewClient(healthcheck, endpoint)
+	balance := transaction.NewBalanceEndpoint(interfaces.NewTxController(DB))
+	show := transaction.NewShowEndpoint(interfaces.NewTxController(DB))
+	list := transaction.NewListEndpoint(interfaces.NewTxController(DB))
+	return transaction.NewClient(healthcheck, endpoint, balance, show, list)
+}
+
+func createTestCorrectionClient() *correction.Client {
+	create := correction.NewCreateEndpoint(interfaces.NewCorrectionController(DB))
+	list := correction.NewListEndpoint(interfaces.NewCorrectionController(DB))
+	return correction.NewClient(create, list)
 }
 
 func connectToTestDB(ctx context.Context) *gorm.DB {
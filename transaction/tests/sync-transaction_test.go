@@ -0,0 +1,84 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	goa "goa.design/goa/v3/pkg"
+	"time"
+	"wallet/gen/transaction"
+	"wallet/transaction/internal/domain/entities"
+	"wallet/transaction/workers"
+)
+
+var _ = Describe("synchronous transaction creation", func() {
+	var payload *transaction.CreatePayload
+
+	BeforeEach(func() {
+		payload = &transaction.CreatePayload{
+			State:         entities.Win,
+			Amount:        "10.01",
+			TransactionID: uuid.New().String(),
+			WalletID:      uuid.New().String(),
+			SourceType:    entities.Game,
+			Wait:          true,
+		}
+
+		runBalanceWorker()
+	})
+
+	When("a transaction with positive amount is received", func() {
+		var (
+			result *transaction.CreateResult
+			err    error
+		)
+
+		BeforeEach(func(ctx context.Context) {
+			result, err = client.Create(ctx, payload)
+		})
+
+		It("should return the balance after processing", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Outcome).To(Equal("processed"))
+			Expect(*result.Balance).To(Equal("10.01"))
+		})
+	})
+
+	When("a transaction exceeding the balance is received", func() {
+		var err error
+
+		BeforeEach(func(ctx context.Context) {
+			payload.State = entities.Lost
+			payload.Amount = "-10.01"
+			_, err = client.Create(ctx, payload)
+		})
+
+		It("insufficient funds error should be returned", func() {
+			var serviceErr *goa.ServiceError
+			Expect(errors.As(err, &serviceErr)).To(BeTrue())
+			Expect(serviceErr.Name).To(Equal("insufficient_funds"))
+		})
+	})
+})
+
+// runBalanceWorker processes transactions in the background until the end of the spec.
+func runBalanceWorker() {
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+		worker := workers.NewBalanceWorker(DB, uuid.New())
+		for ctx.Err() == nil {
+			_ = worker.Execute()
+			time.Sleep(10 * time.Millisecond)
+		}
+	}()
+
+	DeferCleanup(func() {
+		cancel()
+		<-done
+	})
+}
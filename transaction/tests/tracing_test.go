@@ -0,0 +1,81 @@
+package tests
+
+import (
+	"context"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+	"wallet/gen/correction"
+	"wallet/gen/transaction"
+	"wallet/transaction/internal/domain/entities"
+	"wallet/transaction/internal/infrastructure/tracing"
+)
+
+var _ = Describe("request tracing", func() {
+	var (
+		recorder *tracetest.SpanRecorder
+		payload  *transaction.CreatePayload
+	)
+
+	BeforeEach(func(ctx context.Context) {
+		payload = &transaction.CreatePayload{
+			State:         entities.Win,
+			Amount:        "10.15",
+			TransactionID: uuid.New().String(),
+			WalletID:      uuid.New().String(),
+			SourceType:    entities.Game,
+		}
+		_, err := client.Create(ctx, payload)
+		Expect(err).NotTo(HaveOccurred())
+
+		recorder = tracetest.NewSpanRecorder()
+		otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+		DeferCleanup(func() {
+			otel.SetTracerProvider(noop.NewTracerProvider())
+		})
+	})
+
+	// queriesOf returns the number of the query spans started within the span of the request.
+	queriesOf := func(request trace.SpanContext) int {
+		queries := 0
+		for _, span := range recorder.Ended() {
+			if span.Parent().SpanID() == request.SpanID() {
+				queries++
+			}
+		}
+
+		return queries
+	}
+
+	DescribeTable("the queries of a request should be traced within the request span",
+		func(ctx context.Context, request func(ctx context.Context) error) {
+			ctx, span := tracing.Tracer().Start(ctx, "request")
+			err := request(ctx)
+			span.End()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(queriesOf(span.SpanContext())).To(BeNumerically(">", 0))
+		},
+		Entry("balance", func(ctx context.Context) error {
+			_, err := client.Balance(ctx, &transaction.BalancePayload{WalletID: payload.WalletID})
+			return err
+		}),
+		Entry("transaction lookup", func(ctx context.Context) error {
+			_, err := client.Show(ctx, &transaction.ShowPayload{TransactionID: payload.TransactionID, SourceType: payload.SourceType})
+			return err
+		}),
+		Entry("transaction history", func(ctx context.Context) error {
+			_, err := client.List(ctx, &transaction.ListPayload{WalletID: &payload.WalletID, Limit: 10})
+			return err
+		}),
+		Entry("correction runs", func(ctx context.Context) error {
+			_, err := correctionClient.List(ctx, &correction.ListPayload{Limit: 10})
+			return err
+		}),
+	)
+})
@@ -0,0 +1,57 @@
+package workers
+
+import (
+	"context"
+	"time"
+)
+
+// Backoff paces the rounds of a worker: after a round which found work the next round starts immediately, after
+// an idle round the worker waits for a notification or the poll interval, which doubles with every idle round up
+// to the maximum.
+type Backoff struct {
+	Notifications <-chan struct{}
+	MinInterval   time.Duration
+	MaxInterval   time.Duration
+	interval      time.Duration
+}
+
+// Wait blocks until the next round should start, returns false if the context is done.
+func (b *Backoff) Wait(ctx context.Context, busy bool) bool {
+	if busy {
+		b.Reset()
+		return ctx.Err() == nil
+	}
+
+	if b.interval == 0 {
+		b.interval = b.MinInterval
+	} else {
+		b.interval = min(2*b.interval, b.MaxInterval)
+	}
+
+	timer := time.NewTimer(b.interval)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return false
+	case <-b.Notifications:
+		b.interval = 0
+		return true
+	case <-timer.C:
+		return true
+	}
+}
+
+// Reset makes the next idle round wait for the minimum interval again.
+func (b *Backoff) Reset() {
+	b.interval = 0
+}
+
+// NewBackoff returns Backoff instance woken up by the given notifications, which may be nil.
+func NewBackoff(notifications <-chan struct{}, minInterval time.Duration, maxInterval time.Duration) *Backoff {
+	return &Backoff{
+		Notifications: notifications,
+		MinInterval:   minInterval,
+		MaxInterval:   max(minInterval, maxInterval),
+	}
+}
@@ -0,0 +1,61 @@
+package workers_test
+
+import (
+	"context"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"time"
+	"wallet/transaction/workers"
+)
+
+var _ = Describe("worker backoff", func() {
+	var (
+		notifications chan struct{}
+		backoff       *workers.Backoff
+	)
+
+	BeforeEach(func() {
+		notifications = make(chan struct{}, 1)
+		backoff = workers.NewBackoff(notifications, 50*time.Millisecond, time.Minute)
+	})
+
+	When("the previous round found work", func() {
+		It("the next round should start immediately", func(ctx context.Context) {
+			start := time.Now()
+			Expect(backoff.Wait(ctx, true)).To(BeTrue())
+			Expect(time.Since(start)).To(BeNumerically("<", 50*time.Millisecond))
+		})
+	})
+
+	When("the previous round was idle", func() {
+		It("the next round should start after the poll interval", func(ctx context.Context) {
+			start := time.Now()
+			Expect(backoff.Wait(ctx, false)).To(BeTrue())
+			Expect(time.Since(start)).To(BeNumerically(">=", 50*time.Millisecond))
+		})
+
+		It("the poll interval should double with every idle round", func(ctx context.Context) {
+			Expect(backoff.Wait(ctx, false)).To(BeTrue())
+
+			start := time.Now()
+			Expect(backoff.Wait(ctx, false)).To(BeTrue())
+			Expect(time.Since(start)).To(BeNumerically(">=", 100*time.Millisecond))
+		})
+
+		It("a notification should start the next round immediately", func(ctx context.Context) {
+			backoff.MinInterval = time.Minute
+			notifications <- struct{}{}
+
+			start := time.Now()
+			Expect(backoff.Wait(ctx, false)).To(BeTrue())
+			Expect(time.Since(start)).To(BeNumerically("<", time.Second))
+		})
+
+		It("should stop when the context is done", func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+
+			Expect(backoff.Wait(ctx, false)).To(BeFalse())
+		})
+	})
+})
@@ -3,104 +3,159 @@ package workers
 import (
 	"context"
 	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
 	"goa.design/clue/log"
 	"gorm.io/gorm"
+	"sync"
+	"time"
 	"wallet/transaction/internal/domain/entities"
 	"wallet/transaction/internal/domain/repositories"
 	"wallet/transaction/internal/domain/services"
+	"wallet/transaction/internal/infrastructure/metrics"
+	"wallet/transaction/internal/infrastructure/tracing"
 )
 
-// RunBalanceWorker starts a background goroutine that continuously executes the balance worker,
-// handling transactions and rolling back on errors until the context is done.
-func RunBalanceWorker(ctx context.Context, db *gorm.DB) {
+// RunBalanceWorker starts a background goroutine that executes the balance worker claiming batches of the given size
+// under the supervisor until the context is done. The rounds are paced by the backoff, a failed round is rolled back
+// and retried by the supervisor. The worker is registered in the wait group: on shutdown it finishes the current
+// round, releases the transactions it still holds and only then reports that it has stopped. The queries of a round
+// are not cancelled with the context, so the current batch is committed, the context is only checked between rounds.
+func RunBalanceWorker(ctx context.Context, db *gorm.DB, batchSize int, backoff *Backoff, supervisor *Supervisor, wg *sync.WaitGroup) {
+	if batchSize <= 0 {
+		batchSize = DefaultBalanceBatchSize
+	}
+
+	(*wg).Add(1)
 	go func(ctx context.Context) {
+		defer (*wg).Done()
+
 		lockUuid := uuid.New()
-		for {
-			func() {
-				defer func() {
-					if r := recover(); r != nil {
-						log.Printf(ctx, "Recovered from panic")
-					}
-				}()
-			}()
-			for {
-				select {
-				case <-ctx.Done():
-					return
-				default:
-					tx := db.Begin()
-					worker := NewBalanceWorker(tx, lockUuid)
-					err := worker.Execute()
-					if err != nil {
-						tx.Rollback()
-						log.Fatalf(ctx, err, "cannot Execute balance worker")
-						continue
-					}
-					_ = tx.Commit().Error
-				}
+		supervisor.Run(ctx, backoff, func() (bool, error) {
+			// keeps the values of the context, e.g. the tracer, but not its cancellation
+			roundCtx := context.WithoutCancel(ctx)
+
+			var processed []entities.Transaction
+			err := db.WithContext(roundCtx).Transaction(func(tx *gorm.DB) error {
+				worker := NewBalanceWorker(tx, lockUuid)
+				worker.BatchSize = batchSize
+
+				var err error
+				processed, err = worker.Process(roundCtx)
+
+				return err
+			})
+			if err == nil {
+				recordProcessed(processed)
 			}
+
+			// a full batch of processed transactions means more are probably waiting, the blocked ones are not
+			// counted, so a worker holding transactions of a blocked wallet backs off
+			return len(processed) == batchSize, err
+		})
+
+		released, err := NewBalanceWorker(db, lockUuid).Release()
+		if err != nil {
+			log.Errorf(ctx, err, "Cannot release transactions claimed by balance worker")
 		}
+		log.Printf(ctx, "Balance worker stopped, released %d transactions", released)
 	}(ctx)
 }
 
-type Locker interface {
-	LockNewTransactions(lockUuid uuid.UUID) error
-}
+// DefaultBalanceBatchSize is the number of transactions claimed by the balance worker at once.
+const DefaultBalanceBatchSize = 100
 
-type Provider interface {
-	GetLockedTransactions() ([]entities.Transaction, error)
+type Claimer interface {
+	ClaimBatch(lockUuid uuid.UUID, limit int) ([]repositories.ClaimedTransaction, error)
+	ReleaseClaims(lockUuid uuid.UUID) (int, error)
 }
 
 type Processor interface {
-	Execute(*entities.Transaction) error
+	Execute(ctx context.Context, transaction *entities.Transaction) error
 }
 
-// BalanceWorker is responsible for monitoring new correction requests, initiating correction processing,
-// tracking new transactions, and initiating their processing.
+// BalanceWorker is responsible for claiming batches of new transactions and initiating their processing.
 type BalanceWorker struct {
 	LockUuid  uuid.UUID
-	Locker    Locker
-	Provider  Provider
+	BatchSize int
+	Claimer   Claimer
 	Processor Processor
 }
 
-// Execute retrieves and processes the current correction if available,
-// then retrieves and processes the next transaction.
+// Execute claims a batch of new transactions and processes them wallet by wallet.
 func (b BalanceWorker) Execute() error {
-	// Lock transactions
-	err := b.Locker.LockNewTransactions(b.LockUuid)
+	processed, err := b.Process(context.Background())
 	if err != nil {
 		return err
 	}
+	recordProcessed(processed)
+
+	return nil
+}
 
-	//get  all locked transactions
-	transactions, err := b.Provider.GetLockedTransactions()
+// Process claims a batch of new transactions, processes them wallet by wallet and returns the processed transactions,
+// which are not reported to the metrics until the caller commits them. Every transaction is processed in a span of
+// a new trace linked to the request which created it.
+func (b BalanceWorker) Process(ctx context.Context) ([]entities.Transaction, error) {
+	transactions, err := b.Claimer.ClaimBatch(b.LockUuid, b.BatchSize)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
+	// transactions of a wallet must be processed in order, so a transaction is skipped while an earlier transaction
+	// of its wallet is not claimed by the worker, the skipped transactions stay locked until the next round
+	var processed []entities.Transaction
 	for _, transaction := range transactions {
-		if *transaction.LockUuid != b.LockUuid {
-			return nil // next transactions to handle was booked by another process
+		if transaction.Blocked {
+			continue
 		}
 
-		err := b.Processor.Execute(&transaction)
+		err = b.process(ctx, &transaction.Transaction)
 		if err != nil {
-			return err
+			return nil, err
 		}
+		processed = append(processed, transaction.Transaction)
 	}
 
-	return nil
+	return processed, nil
 }
 
-// NewBalanceWorker returns BalanceWorker instance.
-func NewBalanceWorker(db *gorm.DB, lockUuid uuid.UUID) BalanceWorker {
-	transactionRepository := repositories.NewTransactionRepository(db)
+func (b BalanceWorker) process(ctx context.Context, transaction *entities.Transaction) error {
+	ctx, span := tracing.StartLinked(ctx, "process transaction", transaction.TraceParent,
+		attribute.String("transaction.id", transaction.ID.String()),
+		attribute.String("wallet.id", transaction.WalletID.String()),
+		attribute.String("transaction.source_type", transaction.SourceType),
+	)
+	err := b.Processor.Execute(ctx, transaction)
+	tracing.End(span, err)
 
+	return err
+}
+
+// recordProcessed reports the transactions processed by a committed round to the metrics.
+func recordProcessed(transactions []entities.Transaction) {
+	for _, transaction := range transactions {
+		labels := metrics.TransactionLabels(transaction.SourceType, transaction.Action)
+		if transaction.Status == entities.Done {
+			metrics.TransactionsDone.With(labels).Inc()
+			metrics.ProcessingLatency.WithLabelValues(transaction.SourceType).Observe(time.Since(transaction.CreatedAt).Seconds())
+		} else {
+			metrics.TransactionsCancelled.With(labels).Inc()
+		}
+	}
+}
+
+// Release returns the transactions still claimed by the worker, i.e. the ones of blocked wallets, to the new status
+// and returns their number.
+func (b BalanceWorker) Release() (int, error) {
+	return b.Claimer.ReleaseClaims(b.LockUuid)
+}
+
+// NewBalanceWorker returns BalanceWorker instance claiming batches of the default size.
+func NewBalanceWorker(db *gorm.DB, lockUuid uuid.UUID) BalanceWorker {
 	return BalanceWorker{
 		LockUuid:  lockUuid,
-		Locker:    transactionRepository,
-		Provider:  transactionRepository,
+		BatchSize: DefaultBalanceBatchSize,
+		Claimer:   repositories.NewTransactionRepository(db),
 		Processor: services.NewTransactionProcessor(db),
 	}
 }
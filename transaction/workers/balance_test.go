@@ -1,17 +1,32 @@
 package workers_test
 
 import (
+	"context"
+	"errors"
 	"github.com/google/uuid"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+	"sync"
+	"time"
 	"wallet/transaction/internal/domain/entities"
 	"wallet/transaction/internal/domain/repositories"
 	"wallet/transaction/internal/domain/services"
 	"wallet/transaction/internal/domain/vo"
+	"wallet/transaction/internal/infrastructure/metrics"
 	"wallet/transaction/workers"
 )
 
 var _ = Describe("balance worker processing", func() {
+	var walletID uuid.UUID
+
+	BeforeEach(func() {
+		walletID = uuid.New()
+	})
+
 	Context("an unprocessed transactions not exists", func() {
 		When("the worker starts", func() {
 			var (
@@ -24,7 +39,7 @@ var _ = Describe("balance worker processing", func() {
 				balanceWorker = workers.NewBalanceWorker(DB, uuid.New())
 
 				balanceProvider = services.NewBalanceProvider(DB)
-				balance, err := balanceProvider.Provide()
+				balance, err := balanceProvider.Provide(walletID)
 				Expect(err).ToNot(HaveOccurred())
 				startBalance = balance.Value
 
@@ -33,7 +48,7 @@ var _ = Describe("balance worker processing", func() {
 			})
 
 			It("balance should not be changed", func() {
-				newBalance, err := balanceProvider.Provide()
+				newBalance, err := balanceProvider.Provide(walletID)
 				Expect(err).ToNot(HaveOccurred())
 				Expect(newBalance.Value.String()).To(Equal(startBalance.String()))
 			})
@@ -41,8 +56,14 @@ var _ = Describe("balance worker processing", func() {
 	})
 
 	Context("an unprocessed transaction exists", func() {
+		var (
+			transaction *entities.Transaction
+			doneBefore  float64
+		)
+
 		BeforeEach(func() {
-			_ = createTransaction(1)
+			transaction = createTransaction(walletID, 1)
+			doneBefore = testutil.ToFloat64(metrics.TransactionsDone.With(metrics.TransactionLabels(entities.Game, entities.Win)))
 		})
 
 		When("the worker starts", func() {
@@ -54,7 +75,7 @@ var _ = Describe("balance worker processing", func() {
 
 			BeforeEach(func() {
 				balanceProvider = services.NewBalanceProvider(DB)
-				balance, err := balanceProvider.Provide()
+				balance, err := balanceProvider.Provide(walletID)
 				Expect(err).ToNot(HaveOccurred())
 				startBalance = balance.Value
 
@@ -65,10 +86,39 @@ var _ = Describe("balance worker processing", func() {
 			})
 
 			It("balance should be updated", func() {
-				newBalance, err := balanceProvider.Provide()
+				newBalance, err := balanceProvider.Provide(walletID)
 				Expect(err).ToNot(HaveOccurred())
 				Expect(newBalance.Value.String()).ToNot(Equal(startBalance.String()))
 			})
+
+			It("transaction should be counted as done", func() {
+				done := testutil.ToFloat64(metrics.TransactionsDone.With(metrics.TransactionLabels(entities.Game, entities.Win)))
+				Expect(done).To(Equal(doneBefore + 1))
+			})
+		})
+
+		When("the worker round processing the transaction is rolled back", func() {
+			errRolledBack := errors.New("rolled back")
+
+			BeforeEach(func(ctx context.Context) {
+				err := DB.Transaction(func(tx *gorm.DB) error {
+					processed, err := workers.NewBalanceWorker(tx, uuid.New()).Process(ctx)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(processed).To(HaveLen(1))
+
+					return errRolledBack
+				})
+				Expect(err).To(MatchError(errRolledBack))
+			})
+
+			It("transaction should not be counted", func() {
+				done := testutil.ToFloat64(metrics.TransactionsDone.With(metrics.TransactionLabels(entities.Game, entities.Win)))
+				Expect(done).To(Equal(doneBefore))
+
+				transaction, err := repositories.NewTransactionRepository(DB).FindByID(transaction.ID)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(transaction.Status).To(Equal(entities.New))
+			})
 		})
 	})
 
@@ -77,7 +127,7 @@ var _ = Describe("balance worker processing", func() {
 
 		BeforeEach(func() {
 			lockUuid = uuid.New()
-			createLockedTransaction(&lockUuid)
+			createLockedTransaction(walletID, &lockUuid)
 		})
 
 		When("the worker starts", func() {
@@ -89,7 +139,7 @@ var _ = Describe("balance worker processing", func() {
 
 			BeforeEach(func() {
 				balanceProvider = services.NewBalanceProvider(DB)
-				balance, err := balanceProvider.Provide()
+				balance, err := balanceProvider.Provide(walletID)
 				Expect(err).ToNot(HaveOccurred())
 				startBalance = balance.Value
 
@@ -100,7 +150,7 @@ var _ = Describe("balance worker processing", func() {
 			})
 
 			It("balance should not be changed", func() {
-				newBalance, err := balanceProvider.Provide()
+				newBalance, err := balanceProvider.Provide(walletID)
 				Expect(err).ToNot(HaveOccurred())
 				Expect(newBalance.Value.String()).To(Equal(startBalance.String()))
 			})
@@ -114,7 +164,7 @@ var _ = Describe("balance worker processing", func() {
 
 		BeforeEach(func() {
 			lockUuid = uuid.New()
-			_ = createLockedTransaction(&lockUuid)
+			_ = createLockedTransaction(walletID, &lockUuid)
 		})
 
 		When("the worker starts", func() {
@@ -126,7 +176,7 @@ var _ = Describe("balance worker processing", func() {
 
 			BeforeEach(func() {
 				balanceProvider = services.NewBalanceProvider(DB)
-				balance, err := balanceProvider.Provide()
+				balance, err := balanceProvider.Provide(walletID)
 				Expect(err).ToNot(HaveOccurred())
 				startBalance = balance.Value
 
@@ -137,13 +187,138 @@ var _ = Describe("balance worker processing", func() {
 			})
 
 			It("balance should be changed", func() {
-				newBalance, err := balanceProvider.Provide()
+				newBalance, err := balanceProvider.Provide(walletID)
 				Expect(err).ToNot(HaveOccurred())
 				Expect(newBalance.Value.String()).ToNot(Equal(startBalance.String()))
 			})
 		})
 	})
 
+	Context("two unprocessed transactions exist", func() {
+		var (
+			transaction1 *entities.Transaction
+			transaction2 *entities.Transaction
+		)
+
+		BeforeEach(func() {
+			transaction1 = createTransaction(walletID, 1)
+			transaction2 = createTransaction(walletID, 2)
+		})
+
+		When("the worker claims a batch of one transaction", func() {
+			var (
+				lockUuid              uuid.UUID
+				transactionRepository *repositories.TransactionRepository
+			)
+
+			BeforeEach(func() {
+				lockUuid = uuid.New()
+				balanceWorker := workers.NewBalanceWorker(DB, lockUuid)
+				balanceWorker.BatchSize = 1
+
+				transactionRepository = repositories.NewTransactionRepository(DB)
+
+				err := balanceWorker.Execute()
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("only the oldest transaction should be processed", func() {
+				var err error
+				transaction1, err = transactionRepository.FindByID(transaction1.ID)
+				Expect(err).ToNot(HaveOccurred())
+				transaction2, err = transactionRepository.FindByID(transaction2.ID)
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(transaction1.Status).To(Equal(entities.Done))
+				Expect(transaction2.Status).To(Equal(entities.New))
+			})
+		})
+
+		When("the oldest transaction is claimed by another process and the worker starts", func() {
+			var (
+				lockUuid              uuid.UUID
+				transactionRepository *repositories.TransactionRepository
+				processed             []entities.Transaction
+			)
+
+			BeforeEach(func(ctx context.Context) {
+				transactionRepository = repositories.NewTransactionRepository(DB)
+				claimed, err := transactionRepository.ClaimBatch(uuid.New(), 1)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(claimed).To(HaveLen(1))
+
+				lockUuid = uuid.New()
+				processed, err = workers.NewBalanceWorker(DB, lockUuid).Process(ctx)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("the blocked transaction should not be counted as processed", func() {
+				Expect(processed).To(BeEmpty())
+			})
+
+			It("the blocked transaction should be flagged when it is claimed again", func() {
+				claimed, err := transactionRepository.ClaimBatch(lockUuid, 10)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(claimed).To(HaveLen(1))
+				Expect(claimed[0].ID).To(Equal(transaction2.ID))
+				Expect(claimed[0].Blocked).To(BeTrue())
+			})
+
+			It("the later transaction should be claimed but not processed", func() {
+				var err error
+				transaction2, err = transactionRepository.FindByID(transaction2.ID)
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(transaction2.Status).To(Equal(entities.Locked))
+				Expect(*transaction2.LockUuid).To(Equal(lockUuid))
+			})
+		})
+	})
+
+	Context("the worker is stopped while holding transactions of a blocked wallet", func() {
+		var (
+			lockUuid     uuid.UUID
+			transaction1 *entities.Transaction
+			transaction2 *entities.Transaction
+		)
+
+		BeforeEach(func() {
+			lockUuid = uuid.New()
+			randomUuid := uuid.New()
+			transaction1 = createLockedTransaction(walletID, &randomUuid)
+			transaction2 = createLockedTransaction(walletID, &lockUuid)
+		})
+
+		When("the worker releases its claims", func() {
+			var (
+				released              int
+				transactionRepository *repositories.TransactionRepository
+			)
+
+			BeforeEach(func() {
+				transactionRepository = repositories.NewTransactionRepository(DB)
+
+				var err error
+				released, err = workers.NewBalanceWorker(DB, lockUuid).Release()
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("only transactions of the worker should be returned to the new status", func() {
+				var err error
+				transaction1, err = transactionRepository.FindByID(transaction1.ID)
+				Expect(err).ToNot(HaveOccurred())
+				transaction2, err = transactionRepository.FindByID(transaction2.ID)
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(released).To(Equal(1))
+				Expect(transaction1.Status).To(Equal(entities.Locked))
+				Expect(transaction2.Status).To(Equal(entities.New))
+				Expect(transaction2.LockUuid).To(BeNil())
+				Expect(transaction2.LockedAt).To(BeNil())
+			})
+		})
+	})
+
 	Context("three transaction are locked, transaction in the middle are locked by another process", func() {
 		var (
 			lockUuid     uuid.UUID
@@ -154,10 +329,10 @@ var _ = Describe("balance worker processing", func() {
 
 		BeforeEach(func() {
 			lockUuid = uuid.New()
-			transaction1 = createLockedTransaction(&lockUuid)
+			transaction1 = createLockedTransaction(walletID, &lockUuid)
 			randomUuid := uuid.New()
-			transaction2 = createLockedTransaction(&randomUuid)
-			transaction3 = createLockedTransaction(&lockUuid)
+			transaction2 = createLockedTransaction(walletID, &randomUuid)
+			transaction3 = createLockedTransaction(walletID, &lockUuid)
 		})
 
 		When("the worker starts", func() {
@@ -190,4 +365,100 @@ var _ = Describe("balance worker processing", func() {
 			})
 		})
 	})
+	Context("three transaction are locked, transaction in the middle are locked by another process, last one belongs to another wallet", func() {
+		var (
+			lockUuid     uuid.UUID
+			transaction1 *entities.Transaction
+			transaction2 *entities.Transaction
+			transaction3 *entities.Transaction
+		)
+
+		BeforeEach(func() {
+			lockUuid = uuid.New()
+			transaction1 = createLockedTransaction(walletID, &lockUuid)
+			randomUuid := uuid.New()
+			transaction2 = createLockedTransaction(walletID, &randomUuid)
+			transaction3 = createLockedTransaction(uuid.New(), &lockUuid)
+		})
+
+		When("the worker starts", func() {
+			var (
+				balanceWorker         workers.BalanceWorker
+				transactionRepository *repositories.TransactionRepository
+			)
+
+			BeforeEach(func() {
+				balanceWorker = workers.NewBalanceWorker(DB, lockUuid)
+
+				transactionRepository = repositories.NewTransactionRepository(DB)
+
+				err := balanceWorker.Execute()
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("transactions of another wallet should be processed", func() {
+				var err error
+				transaction1, err = transactionRepository.FindByID(transaction1.ID)
+				Expect(err).ToNot(HaveOccurred())
+				transaction2, err = transactionRepository.FindByID(transaction2.ID)
+				Expect(err).ToNot(HaveOccurred())
+				transaction3, err = transactionRepository.FindByID(transaction3.ID)
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(transaction1.Status).To(Equal(entities.Done))
+				Expect(transaction2.Status).To(Equal(entities.Locked))
+				Expect(transaction3.Status).To(Equal(entities.Done))
+			})
+		})
+	})
+
+	Context("the service is stopped while the worker processes a batch", func() {
+		var (
+			transactions []*entities.Transaction
+			supervisor   *workers.Supervisor
+		)
+
+		BeforeEach(func() {
+			transactions = []*entities.Transaction{
+				createTransaction(walletID, 1),
+				createTransaction(walletID, 2),
+				createTransaction(walletID, 3),
+			}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			DeferCleanup(cancel)
+
+			// the context is cancelled by the first update of the batch, i.e. in the middle of the round
+			sqlDB, err := DB.DB()
+			Expect(err).ToNot(HaveOccurred())
+			cancellingDB, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{
+				Logger: logger.Default.LogMode(logger.Silent),
+			})
+			Expect(err).ToNot(HaveOccurred())
+			err = cancellingDB.Callback().Update().Before("gorm:update").Register("test:cancel", func(tx *gorm.DB) {
+				cancel()
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			var wg sync.WaitGroup
+			supervisor = workers.NewSupervisor("cancelled balance", time.Millisecond, time.Millisecond)
+			backoff := workers.NewBackoff(nil, time.Millisecond, time.Millisecond)
+			workers.RunBalanceWorker(ctx, cancellingDB, 10, backoff, supervisor, &wg)
+			wg.Wait()
+		})
+
+		It("the batch should be committed", func() {
+			for _, transaction := range transactions {
+				transaction, err := repositories.NewTransactionRepository(DB).FindByID(transaction.ID)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(transaction.Status).To(Equal(entities.Done))
+			}
+		})
+
+		It("the worker should stop without a crash", func() {
+			health := supervisor.Health()
+			Expect(health.Crashes).To(BeZero())
+			Expect(health.Status).To(Equal(workers.WorkerStopped))
+		})
+	})
 })
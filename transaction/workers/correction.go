@@ -6,48 +6,48 @@ import (
 	"github.com/pkg/errors"
 	"goa.design/clue/log"
 	"gorm.io/gorm"
+	"sync"
 	"time"
 	"wallet/transaction/internal/domain/entities"
 	"wallet/transaction/internal/domain/repositories"
 	"wallet/transaction/internal/domain/services"
 )
 
-// RunCorrectionWorker starts a background goroutine that continuously executes the correction worker, handling
-// transactions and rolling back on errors until the context is done.
+// RunCorrectionWorker starts a background goroutine that executes the correction worker under the supervisor until
+// the context is done. The rounds are paced by the backoff, a failed round is rolled back and retried by the
+// supervisor. The worker is not started if the policy disables corrections. The worker is registered in the wait
+// group: on shutdown it finishes the current round and reports that it has stopped.
 // RunCorrectionWorker запускает рабочий процесс для коррекции
-func RunCorrectionWorker(ctx context.Context, db *gorm.DB) {
-	// lests generate new correction if it does not exist,
-	// we can swallow error here because even at it returns error, we will try again after
-	_, _ = services.NewCorrectionProvider(db).Provide()
+func RunCorrectionWorker(ctx context.Context, db *gorm.DB, policy services.CorrectionPolicy, backoff *Backoff, supervisor *Supervisor, wg *sync.WaitGroup) {
+	if policy.IsDisabled() {
+		log.Printf(ctx, "Corrections are disabled")
+		return
+	}
 
+	(*wg).Add(1)
 	go func(ctx context.Context) {
+		defer (*wg).Done()
+
 		lockUuid := uuid.New()
-		for {
-			func() {
-				defer func() {
-					if r := recover(); r != nil {
-						log.Printf(ctx, "Recovered from panic")
-					}
-				}()
-
-				for {
-					select {
-					case <-ctx.Done():
-						return
-					default:
-						tx := db.Begin()
-						err := NewCorrectionWorker(tx, lockUuid).Execute()
-						if err != nil {
-							tx.Rollback()
-							log.Errorf(ctx, err, "Cannot execute correction worker")
-							continue
-						}
-
-						_ = tx.Commit()
-					}
+		supervisor.Run(ctx, backoff, func() (bool, error) {
+			processed := 0
+			var reversals []services.Reversal
+			err := db.Transaction(func(tx *gorm.DB) error {
+				var err error
+				processed, reversals, err = NewCorrectionWorker(tx, lockUuid, policy).Process()
+
+				return err
+			})
+			if err == nil {
+				for _, reversal := range reversals {
+					reversal.Record()
 				}
-			}()
-		}
+			}
+
+			return processed > 0, err
+		})
+
+		log.Printf(ctx, "Correction worker stopped")
 	}(ctx)
 }
 
@@ -56,56 +56,82 @@ type CorrectionSaver interface {
 	Save(correction *entities.Correction) error
 }
 
-// CorrectionWorker monitors the creation of the latest correction and adds a new one if more than 10 minutes have
-// passed since the last correction was processed.
+// CorrectionWorker monitors the corrections of every wallet and processes the ones for which more than the policy
+// interval has passed since they were processed last time.
 type CorrectionWorker struct {
 	Saver     CorrectionSaver
 	LockUuid  uuid.UUID
+	Policy    services.CorrectionPolicy
 	Provider  CorrectionProvider
 	Locker    CorrectionLocker
 	Processor CorrectionProcessor
 }
 
 type CorrectionProvider interface {
-	Provide() (*entities.Correction, error)
+	ProvideMissing() error
 }
 
 type CorrectionLocker interface {
-	Lock(lockUuid uuid.UUID) error
+	Lock(lockUuid uuid.UUID, interval time.Duration) error
+	GetLockedCorrections(lockUuid uuid.UUID) ([]entities.Correction, error)
 }
 
 type CorrectionProcessor interface {
-	Execute() error
+	Execute(walletID uuid.UUID) ([]services.Reversal, error)
 }
 
-// Execute retrieves the newest correction and, if none exists or the latest correction was processed more than
-// 10 minutes ago, initializes a new correction.
+// Execute schedules corrections for new wallets, locks the corrections which are due and processes the ones
+// locked by the worker.
 func (c CorrectionWorker) Execute() error {
-	err := c.Locker.Lock(c.LockUuid)
+	_, reversals, err := c.Process()
 	if err != nil {
 		return err
 	}
-	correction, err := c.Provider.Provide()
+	for _, reversal := range reversals {
+		reversal.Record()
+	}
 
-	if err != nil {
-		return errors.Wrap(err, "cant get provider")
+	return nil
+}
+
+// Process schedules corrections for new wallets, locks the corrections which are due, processes the ones locked by
+// the worker and returns the number of processed corrections along with their reversals, which are not reported to
+// the metrics until the caller commits them.
+func (c CorrectionWorker) Process() (int, []services.Reversal, error) {
+	if c.Policy.IsDisabled() {
+		return 0, nil, nil
 	}
 
-	if correction.LockUuid == nil || *correction.LockUuid != c.LockUuid {
-		return nil
+	err := c.Provider.ProvideMissing()
+	if err != nil {
+		return 0, nil, errors.Wrap(err, "cant provide corrections")
 	}
 
-	err = c.Processor.Execute()
+	err = c.Locker.Lock(c.LockUuid, c.Policy.Interval)
 	if err != nil {
-		return errors.Wrap(err, "cant execute processor")
+		return 0, nil, err
 	}
 
-	err = c.unLock(correction)
+	corrections, err := c.Locker.GetLockedCorrections(c.LockUuid)
 	if err != nil {
-		return errors.Wrap(err, "cant unlock correction")
+		return 0, nil, errors.Wrap(err, "cant get locked corrections")
 	}
 
-	return nil
+	var reversals []services.Reversal
+	for _, correction := range corrections {
+		walletReversals, err := c.Processor.Execute(correction.WalletID)
+		if err != nil {
+			return 0, nil, errors.Wrap(err, "cant execute processor")
+		}
+		reversals = append(reversals, walletReversals...)
+
+		err = c.unLock(&correction)
+		if err != nil {
+			return 0, nil, errors.Wrap(err, "cant unlock correction")
+		}
+	}
+
+	return len(corrections), reversals, nil
 }
 
 func (c CorrectionWorker) unLock(correction *entities.Correction) error {
@@ -123,14 +149,15 @@ func (c CorrectionWorker) unLock(correction *entities.Correction) error {
 }
 
 // NewCorrectionWorker returns CorrectionWorker instance.
-func NewCorrectionWorker(db *gorm.DB, lockUuid uuid.UUID) CorrectionWorker {
+func NewCorrectionWorker(db *gorm.DB, lockUuid uuid.UUID, policy services.CorrectionPolicy) CorrectionWorker {
 	correctionRepository := repositories.NewCorrectionRepository(db)
 
 	return CorrectionWorker{
 		LockUuid:  lockUuid,
+		Policy:    policy,
 		Provider:  services.NewCorrectionProvider(db),
 		Locker:    correctionRepository,
 		Saver:     correctionRepository,
-		Processor: services.NewCorrectionProcessor(db),
+		Processor: services.NewCorrectionProcessor(db, policy),
 	}
 }
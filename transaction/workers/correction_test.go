@@ -6,11 +6,22 @@ import (
 	. "github.com/onsi/gomega"
 	"wallet/transaction/internal/domain/entities"
 	"wallet/transaction/internal/domain/repositories"
+	"wallet/transaction/internal/domain/services"
 	"wallet/transaction/workers"
 )
 
 var _ = Describe("correction worker", func() {
+	var walletID uuid.UUID
+
+	BeforeEach(func() {
+		walletID = uuid.New()
+	})
+
 	Context("correction does not exists", func() {
+		BeforeEach(func() {
+			createBalance(walletID)
+		})
+
 		When("correction workers started", func() {
 			var (
 				correctionWorker workers.CorrectionWorker
@@ -20,11 +31,11 @@ var _ = Describe("correction worker", func() {
 			)
 
 			BeforeEach(func() {
-				correctionWorker = workers.NewCorrectionWorker(DB, uuid.New())
+				correctionWorker = workers.NewCorrectionWorker(DB, uuid.New(), services.DefaultCorrectionPolicy())
 				correctionRepo = repositories.NewCorrectionRepository(DB)
 
 				transactionRepo = repositories.NewTransactionRepository(DB)
-				tranasction = createDoneTransaction(10)
+				tranasction = createDoneTransaction(walletID, 10)
 
 				err := correctionWorker.Execute()
 				Expect(err).ToNot(HaveOccurred())
@@ -34,7 +45,7 @@ var _ = Describe("correction worker", func() {
 				corrections, err := correctionRepo.FindAll()
 				Expect(err).ToNot(HaveOccurred())
 				Expect(corrections).To(HaveLen(1))
-				Expect(corrections[0].ID.String()).To(Equal(entities.CorrectionId))
+				Expect(corrections[0].WalletID).To(Equal(walletID))
 			})
 
 			It("correction process should not be started", func() {
@@ -44,7 +55,7 @@ var _ = Describe("correction worker", func() {
 			})
 
 			It("should be ready", func() {
-				correction, err := correctionRepo.FindByID(uuid.MustParse(entities.CorrectionId))
+				correction, err := correctionRepo.Get(walletID)
 				Expect(err).ToNot(HaveOccurred())
 
 				Expect(correction.LockUuid).ToNot(BeNil())
@@ -58,7 +69,7 @@ var _ = Describe("correction worker", func() {
 
 		BeforeEach(func() {
 			lockUuid = uuid.New()
-			createLockedCorrection(uuid.New())
+			createLockedCorrection(walletID, uuid.New())
 		})
 
 		When("correction workers started", func() {
@@ -72,10 +83,10 @@ var _ = Describe("correction worker", func() {
 			BeforeEach(func() {
 				correctionRepo = repositories.NewCorrectionRepository(DB)
 
-				correctionWorker = workers.NewCorrectionWorker(DB, lockUuid)
+				correctionWorker = workers.NewCorrectionWorker(DB, lockUuid, services.DefaultCorrectionPolicy())
 
 				transactionRepo = repositories.NewTransactionRepository(DB)
-				tranasction = createDoneTransaction(10)
+				tranasction = createDoneTransaction(walletID, 10)
 
 				err := correctionWorker.Execute()
 				Expect(err).ToNot(HaveOccurred())
@@ -85,7 +96,7 @@ var _ = Describe("correction worker", func() {
 				corrections, err := correctionRepo.FindAll()
 				Expect(err).ToNot(HaveOccurred())
 				Expect(corrections).To(HaveLen(1))
-				Expect(corrections[0].ID.String()).To(Equal(entities.CorrectionId))
+				Expect(corrections[0].WalletID).To(Equal(walletID))
 			})
 
 			It("correction process should not be started", func() {
@@ -95,7 +106,7 @@ var _ = Describe("correction worker", func() {
 			})
 
 			It("should not unlock correction", func() {
-				correction, err := correctionRepo.FindByID(uuid.MustParse(entities.CorrectionId))
+				correction, err := correctionRepo.Get(walletID)
 				Expect(err).ToNot(HaveOccurred())
 
 				Expect(correction.LockUuid).ToNot(BeNil())
@@ -109,7 +120,7 @@ var _ = Describe("correction worker", func() {
 
 		BeforeEach(func() {
 			lockUuid = uuid.New()
-			createLockedCorrection(lockUuid)
+			createLockedCorrection(walletID, lockUuid)
 		})
 
 		When("correction workers started", func() {
@@ -121,11 +132,11 @@ var _ = Describe("correction worker", func() {
 			)
 
 			BeforeEach(func() {
-				correctionWorker = workers.NewCorrectionWorker(DB, lockUuid)
+				correctionWorker = workers.NewCorrectionWorker(DB, lockUuid, services.DefaultCorrectionPolicy())
 				correctionRepo = repositories.NewCorrectionRepository(DB)
 
 				transactionRepo = repositories.NewTransactionRepository(DB)
-				tranasction = createDoneTransaction(10)
+				tranasction = createDoneTransaction(walletID, 10)
 
 				err := correctionWorker.Execute()
 				Expect(err).ToNot(HaveOccurred())
@@ -135,7 +146,7 @@ var _ = Describe("correction worker", func() {
 				corrections, err := correctionRepo.FindAll()
 				Expect(err).ToNot(HaveOccurred())
 				Expect(corrections).To(HaveLen(1))
-				Expect(corrections[0].ID.String()).To(Equal(entities.CorrectionId))
+				Expect(corrections[0].WalletID).To(Equal(walletID))
 			})
 
 			It("correction process should be started", func() {
@@ -145,7 +156,7 @@ var _ = Describe("correction worker", func() {
 			})
 
 			It("should unlock correction", func() {
-				correction, err := correctionRepo.FindByID(uuid.MustParse(entities.CorrectionId))
+				correction, err := correctionRepo.Get(walletID)
 				Expect(err).ToNot(HaveOccurred())
 
 				Expect(correction.LockUuid).To(BeNil())
@@ -159,7 +170,7 @@ var _ = Describe("correction worker", func() {
 
 		BeforeEach(func() {
 			lockUuid = uuid.New()
-			createLockedCorrection(lockUuid)
+			createLockedCorrection(walletID, lockUuid)
 		})
 
 		When("correction workers started", func() {
@@ -171,11 +182,11 @@ var _ = Describe("correction worker", func() {
 			)
 
 			BeforeEach(func() {
-				correctionWorker = workers.NewCorrectionWorker(DB, uuid.New())
+				correctionWorker = workers.NewCorrectionWorker(DB, uuid.New(), services.DefaultCorrectionPolicy())
 				correctionRepo = repositories.NewCorrectionRepository(DB)
 
 				transactionRepo = repositories.NewTransactionRepository(DB)
-				tranasction = createDoneTransaction(10)
+				tranasction = createDoneTransaction(walletID, 10)
 
 				err := correctionWorker.Execute()
 				Expect(err).ToNot(HaveOccurred())
@@ -185,7 +196,7 @@ var _ = Describe("correction worker", func() {
 				corrections, err := correctionRepo.FindAll()
 				Expect(err).ToNot(HaveOccurred())
 				Expect(corrections).To(HaveLen(1))
-				Expect(corrections[0].ID.String()).To(Equal(entities.CorrectionId))
+				Expect(corrections[0].WalletID).To(Equal(walletID))
 			})
 
 			It("correction process should not be started", func() {
@@ -195,7 +206,7 @@ var _ = Describe("correction worker", func() {
 			})
 
 			It("should not unlock correction", func() {
-				correction, err := correctionRepo.FindByID(uuid.MustParse(entities.CorrectionId))
+				correction, err := correctionRepo.Get(walletID)
 				Expect(err).ToNot(HaveOccurred())
 
 				Expect(correction.LockUuid).ToNot(BeNil())
@@ -206,7 +217,7 @@ var _ = Describe("correction worker", func() {
 
 	Context("correction exists and its time to do start new correction", func() {
 		BeforeEach(func() {
-			createReadyCorrection()
+			createReadyCorrection(walletID)
 		})
 
 		When("correction workers started", func() {
@@ -218,11 +229,11 @@ var _ = Describe("correction worker", func() {
 			)
 
 			BeforeEach(func() {
-				correctionWorker = workers.NewCorrectionWorker(DB, uuid.New())
+				correctionWorker = workers.NewCorrectionWorker(DB, uuid.New(), services.DefaultCorrectionPolicy())
 				correctionRepo = repositories.NewCorrectionRepository(DB)
 
 				transactionRepo = repositories.NewTransactionRepository(DB)
-				tranasction = createDoneTransaction(10)
+				tranasction = createDoneTransaction(walletID, 10)
 
 				err := correctionWorker.Execute()
 				Expect(err).ToNot(HaveOccurred())
@@ -232,7 +243,7 @@ var _ = Describe("correction worker", func() {
 				corrections, err := correctionRepo.FindAll()
 				Expect(err).ToNot(HaveOccurred())
 				Expect(corrections).To(HaveLen(1))
-				Expect(corrections[0].ID.String()).To(Equal(entities.CorrectionId))
+				Expect(corrections[0].WalletID).To(Equal(walletID))
 			})
 
 			It("correction process should be started", func() {
@@ -245,7 +256,7 @@ var _ = Describe("correction worker", func() {
 				err := correctionWorker.Execute()
 				Expect(err).ToNot(HaveOccurred())
 
-				correction, err := correctionRepo.FindByID(uuid.MustParse(entities.CorrectionId))
+				correction, err := correctionRepo.Get(walletID)
 				Expect(err).ToNot(HaveOccurred())
 
 				Expect(correction.LockUuid).To(BeNil())
@@ -254,9 +265,49 @@ var _ = Describe("correction worker", func() {
 		})
 	})
 
+	Context("corrections of two wallets exist and its time to do start new correction", func() {
+		var anotherWalletID uuid.UUID
+
+		BeforeEach(func() {
+			anotherWalletID = uuid.New()
+			createReadyCorrection(walletID)
+			createReadyCorrection(anotherWalletID)
+		})
+
+		When("correction workers started", func() {
+			var (
+				correctionWorker   workers.CorrectionWorker
+				transactionRepo    *repositories.TransactionRepository
+				tranasction        *entities.Transaction
+				anotherTransaction *entities.Transaction
+			)
+
+			BeforeEach(func() {
+				correctionWorker = workers.NewCorrectionWorker(DB, uuid.New(), services.DefaultCorrectionPolicy())
+
+				transactionRepo = repositories.NewTransactionRepository(DB)
+				tranasction = createDoneTransaction(walletID, 10)
+				anotherTransaction = createDoneTransaction(anotherWalletID, 10)
+
+				err := correctionWorker.Execute()
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("correction process should be started for each wallet", func() {
+				transactionAfterProcess, err := transactionRepo.FindByID(tranasction.ID)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(transactionAfterProcess.Status).To(Equal(entities.Cancelled))
+
+				transactionAfterProcess, err = transactionRepo.FindByID(anotherTransaction.ID)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(transactionAfterProcess.Status).To(Equal(entities.Cancelled))
+			})
+		})
+	})
+
 	Context("correction exists and frozen", func() {
 		BeforeEach(func() {
-			createFrozenCorrection()
+			createFrozenCorrection(walletID)
 		})
 
 		When("correction workers started", func() {
@@ -268,11 +319,11 @@ var _ = Describe("correction worker", func() {
 			)
 
 			BeforeEach(func() {
-				correctionWorker = workers.NewCorrectionWorker(DB, uuid.New())
+				correctionWorker = workers.NewCorrectionWorker(DB, uuid.New(), services.DefaultCorrectionPolicy())
 				correctionRepo = repositories.NewCorrectionRepository(DB)
 
 				transactionRepo = repositories.NewTransactionRepository(DB)
-				tranasction = createDoneTransaction(10)
+				tranasction = createDoneTransaction(walletID, 10)
 
 				err := correctionWorker.Execute()
 				Expect(err).ToNot(HaveOccurred())
@@ -282,7 +333,7 @@ var _ = Describe("correction worker", func() {
 				corrections, err := correctionRepo.FindAll()
 				Expect(err).ToNot(HaveOccurred())
 				Expect(corrections).To(HaveLen(1))
-				Expect(corrections[0].ID.String()).To(Equal(entities.CorrectionId))
+				Expect(corrections[0].WalletID).To(Equal(walletID))
 			})
 
 			It("correction process should be started", func() {
@@ -292,7 +343,7 @@ var _ = Describe("correction worker", func() {
 			})
 
 			It("should unlock correction", func() {
-				correction, err := correctionRepo.FindByID(uuid.MustParse(entities.CorrectionId))
+				correction, err := correctionRepo.Get(walletID)
 				Expect(err).ToNot(HaveOccurred())
 
 				Expect(correction.LockUuid).To(BeNil())
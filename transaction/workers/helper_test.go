@@ -10,9 +10,9 @@ import (
 	"wallet/transaction/internal/domain/vo"
 )
 
-func createLockedCorrection(lockId uuid.UUID) *entities.Correction {
+func createLockedCorrection(walletID uuid.UUID, lockId uuid.UUID) *entities.Correction {
 	GinkgoHelper()
-	correction := entities.NewCorrection(uuid.MustParse(entities.CorrectionId))
+	correction := entities.NewCorrection(walletID)
 	correction.Lock(lockId)
 	now := time.Now()
 	correction.LockedAt = &now
@@ -22,8 +22,8 @@ func createLockedCorrection(lockId uuid.UUID) *entities.Correction {
 	return correction
 }
 
-func createReadyCorrection() *entities.Correction {
-	correction := entities.NewCorrection(uuid.MustParse(entities.CorrectionId))
+func createReadyCorrection(walletID uuid.UUID) *entities.Correction {
+	correction := entities.NewCorrection(walletID)
 	correction.Status = entities.Ready
 	doneAt := time.Now().Add(-11 * time.Minute)
 	correction.LockedAt = &doneAt
@@ -35,8 +35,8 @@ func createReadyCorrection() *entities.Correction {
 	return correction
 }
 
-func createFrozenCorrection() *entities.Correction {
-	correction := entities.NewCorrection(uuid.MustParse(entities.CorrectionId))
+func createFrozenCorrection(walletID uuid.UUID) *entities.Correction {
+	correction := entities.NewCorrection(walletID)
 	correction.Status = entities.Locked
 	lockedAt := time.Now().Add(-11 * time.Minute)
 	correction.LockedAt = &lockedAt
@@ -49,19 +49,28 @@ func createFrozenCorrection() *entities.Correction {
 	return correction
 }
 
-func createTransaction(amount int) *entities.Transaction {
+func createBalance(walletID uuid.UUID) *entities.Balance {
 	GinkgoHelper()
-	return createTransactionWithStatus(amount, entities.New)
+	balance := entities.NewBalance(walletID, vo.NewTotalAmount(0), vo.DefaultCurrency)
+	err := repositories.NewBalanceRepository(DB).Save(balance)
+	Expect(err).ToNot(HaveOccurred())
+
+	return balance
+}
+
+func createTransaction(walletID uuid.UUID, amount int) *entities.Transaction {
+	GinkgoHelper()
+	return createTransactionWithStatus(walletID, amount, entities.New)
 }
 
-func createDoneTransaction(amount int) *entities.Transaction {
+func createDoneTransaction(walletID uuid.UUID, amount int) *entities.Transaction {
 	GinkgoHelper()
-	return createTransactionWithStatus(amount, entities.Done)
+	return createTransactionWithStatus(walletID, amount, entities.Done)
 }
 
-func createLockedTransaction(lockId *uuid.UUID) *entities.Transaction {
+func createLockedTransaction(walletID uuid.UUID, lockId *uuid.UUID) *entities.Transaction {
 	GinkgoHelper()
-	transaction := createTransactionWithStatus(10, entities.Locked)
+	transaction := createTransactionWithStatus(walletID, 10, entities.Locked)
 	transaction.LockUuid = lockId
 	now := time.Now()
 	transaction.LockedAt = &now
@@ -72,7 +81,7 @@ func createLockedTransaction(lockId *uuid.UUID) *entities.Transaction {
 	return transaction
 }
 
-func createTransactionWithStatus(amount int, status string) *entities.Transaction {
+func createTransactionWithStatus(walletID uuid.UUID, amount int, status string) *entities.Transaction {
 	GinkgoHelper()
 
 	action := entities.Win
@@ -82,7 +91,7 @@ func createTransactionWithStatus(amount int, status string) *entities.Transactio
 	}
 
 	transactionRepo := repositories.NewTransactionRepository(DB)
-	transaction := entities.NewTransaction(uuid.New().String(), vo.NewAmount(amount), action, entities.Game)
+	transaction := entities.NewTransaction(uuid.New().String(), walletID, vo.NewAmount(amount), vo.DefaultCurrency, action, entities.Game)
 	transaction.Status = status
 
 	err := transactionRepo.Save(transaction)
@@ -0,0 +1,106 @@
+package workers
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"goa.design/clue/log"
+	"gorm.io/gorm"
+	"sync"
+	"time"
+	"wallet/transaction/internal/domain/entities"
+	"wallet/transaction/internal/domain/repositories"
+	"wallet/transaction/internal/domain/services"
+	"wallet/transaction/internal/infrastructure/metrics"
+)
+
+// RunReconciliationWorker starts a background goroutine that reconciles the balances of all wallets every interval
+// until the context is done. The worker is not started if the interval is not positive, otherwise it is registered
+// in the wait group and stops after the current round.
+func RunReconciliationWorker(ctx context.Context, db *gorm.DB, interval time.Duration, autoRepair bool, wg *sync.WaitGroup) {
+	if interval <= 0 {
+		log.Printf(ctx, "Balance reconciliation is disabled")
+		return
+	}
+
+	(*wg).Add(1)
+	go func(ctx context.Context) {
+		defer (*wg).Done()
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				log.Printf(ctx, "Reconciliation worker stopped")
+				return
+			case <-ticker.C:
+				discrepancies, err := NewReconciliationWorker(db, autoRepair).Execute()
+				if err != nil {
+					log.Errorf(ctx, err, "Cannot execute reconciliation worker")
+				}
+				for _, discrepancy := range discrepancies {
+					log.Printf(ctx, "Balance of wallet %s differs from its transactions by %d, repaired: %t",
+						discrepancy.WalletID, discrepancy.Drift(), discrepancy.Repaired)
+				}
+			}
+		}
+	}(ctx)
+}
+
+// ReconciliationWorker compares the stored balance of every wallet with the sum of its done transactions.
+type ReconciliationWorker struct {
+	DB         *gorm.DB
+	AutoRepair bool
+}
+
+// Execute reconciles the wallets one by one, each within its own database transaction, and returns the detected
+// discrepancies. A wallet whose balance differs from the ledger does not stop the reconciliation of the other wallets,
+// the mismatch is returned after all wallets are reconciled.
+func (r ReconciliationWorker) Execute() ([]entities.BalanceDiscrepancy, error) {
+	walletIDs, err := repositories.NewBalanceRepository(r.DB).FindWalletIDs()
+	if err != nil {
+		return nil, errors.Wrap(err, "cant find wallets")
+	}
+
+	var discrepancies []entities.BalanceDiscrepancy
+	var ledgerErr error
+	mismatches := 0
+	for _, walletID := range walletIDs {
+		var discrepancy *entities.BalanceDiscrepancy
+		err = r.DB.Transaction(func(tx *gorm.DB) error {
+			var err error
+			discrepancy, err = services.NewReconciler(tx, r.AutoRepair).Execute(walletID)
+			return err
+		})
+		if errors.Is(err, services.ErrLedgerMismatch) {
+			if ledgerErr == nil {
+				ledgerErr = err
+			}
+			mismatches++
+			continue
+		}
+		if err != nil {
+			return discrepancies, errors.Wrapf(err, "cant reconcile wallet %s", walletID)
+		}
+
+		if discrepancy != nil {
+			discrepancies = append(discrepancies, *discrepancy)
+		}
+	}
+
+	metrics.BalanceDiscrepancies.Set(float64(len(discrepancies)))
+	if ledgerErr != nil {
+		return discrepancies, errors.Wrapf(ledgerErr, "balances of %d wallets differ from the ledger", mismatches)
+	}
+
+	return discrepancies, nil
+}
+
+// NewReconciliationWorker returns ReconciliationWorker instance.
+func NewReconciliationWorker(db *gorm.DB, autoRepair bool) ReconciliationWorker {
+	return ReconciliationWorker{
+		DB:         db,
+		AutoRepair: autoRepair,
+	}
+}
@@ -0,0 +1,103 @@
+package workers
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"goa.design/clue/log"
+	"gorm.io/gorm"
+	"sync"
+	"time"
+	"wallet/transaction/internal/domain/repositories"
+	"wallet/transaction/internal/domain/services"
+)
+
+// SnapshotDelay is how far behind the current time the snapshots are taken, so the transactions processed before
+// the snapshot time are committed by the time the snapshot is taken.
+const SnapshotDelay = time.Minute
+
+// RunSnapshotWorker starts a background goroutine that takes the balance snapshots every interval until the context
+// is done. The worker is not started if the interval is not positive, otherwise it is registered in the wait group
+// and stops after the current round.
+func RunSnapshotWorker(ctx context.Context, db *gorm.DB, interval time.Duration, wg *sync.WaitGroup) {
+	if interval <= 0 {
+		log.Printf(ctx, "Balance snapshots are disabled")
+		return
+	}
+
+	(*wg).Add(1)
+	go func(ctx context.Context) {
+		defer (*wg).Done()
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				log.Printf(ctx, "Snapshot worker stopped")
+				return
+			case <-ticker.C:
+				err := NewSnapshotWorker(db).Execute(time.Now().Add(-SnapshotDelay))
+				if err != nil {
+					log.Errorf(ctx, err, "Cannot execute snapshot worker")
+				}
+			}
+		}
+	}(ctx)
+}
+
+type SnapshotWalletFinder interface {
+	FindWalletsProcessedBetween(from time.Time, to time.Time) ([]uuid.UUID, error)
+}
+
+type SnapshotTimeProvider interface {
+	LatestTakenAt() (*time.Time, error)
+}
+
+type Snapshotter interface {
+	Snapshot(walletID uuid.UUID, at time.Time) error
+}
+
+// SnapshotWorker takes the balance snapshots of the wallets which have transactions processed since the previous
+// snapshots.
+type SnapshotWorker struct {
+	Finder      SnapshotWalletFinder
+	Provider    SnapshotTimeProvider
+	Snapshotter Snapshotter
+}
+
+// Execute takes the snapshots of the changed wallets at the given time.
+func (s SnapshotWorker) Execute(at time.Time) error {
+	var since time.Time
+	latest, err := s.Provider.LatestTakenAt()
+	if err != nil {
+		return errors.Wrap(err, "cant get latest snapshot time")
+	}
+	if latest != nil {
+		since = *latest
+	}
+
+	walletIDs, err := s.Finder.FindWalletsProcessedBetween(since, at)
+	if err != nil {
+		return errors.Wrap(err, "cant find changed wallets")
+	}
+
+	for _, walletID := range walletIDs {
+		err = s.Snapshotter.Snapshot(walletID, at)
+		if err != nil {
+			return errors.Wrap(err, "cant take snapshot")
+		}
+	}
+
+	return nil
+}
+
+// NewSnapshotWorker returns SnapshotWorker instance.
+func NewSnapshotWorker(db *gorm.DB) SnapshotWorker {
+	return SnapshotWorker{
+		Finder:      repositories.NewTransactionRepository(db),
+		Provider:    repositories.NewBalanceSnapshotRepository(db),
+		Snapshotter: services.NewBalanceHistory(db),
+	}
+}
@@ -0,0 +1,177 @@
+package workers
+
+import (
+	"context"
+	"expvar"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/pkg/errors"
+	"goa.design/clue/log"
+	"runtime/debug"
+	"strings"
+	"sync"
+	"time"
+	"wallet/transaction/internal/infrastructure/metrics"
+)
+
+// Statuses of a supervised worker.
+const (
+	WorkerRunning    = "running"
+	WorkerRestarting = "restarting"
+	WorkerStopped    = "stopped"
+	WorkerFailed     = "failed"
+)
+
+// ErrPanic is returned for a worker round which panicked.
+var ErrPanic = errors.New("worker panicked")
+
+// workerCrashes counts the failed rounds of every worker, published on /debug/vars.
+var workerCrashes = expvar.NewMap("worker_crashes")
+
+// fatalErrorClasses are the PostgreSQL error classes and codes which cannot be fixed by running the round again:
+// invalid authorization, invalid catalog name, syntax error or access rule violation, i.e. the schema is not migrated,
+// and not-null and check constraint violations, as the retried round writes the same rows again. Unique violations
+// are not fatal, a concurrent instance may have written the conflicting row.
+var fatalErrorClasses = []string{"28", "3D", "42", "23502", "23514"}
+
+var (
+	supervisorsMu sync.Mutex
+	supervisors   []*Supervisor
+)
+
+// IsFatal returns true if the error of a worker round cannot be fixed by retrying the round, every other error,
+// e.g. a lost connection, a deadlock or a panic, is considered retryable.
+func IsFatal(err error) bool {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		for _, class := range fatalErrorClasses {
+			if strings.HasPrefix(pgErr.Code, class) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// WorkerHealth is the state of a supervised worker reported by the health check.
+type WorkerHealth struct {
+	Name      string
+	Status    string
+	Crashes   int64
+	LastError error
+}
+
+// Supervisor runs the rounds of a worker, recovering from panics and retrying the failed rounds after the restart
+// backoff. The worker is stopped when the context is done or a round fails with a fatal error.
+type Supervisor struct {
+	Name    string
+	Restart *Backoff
+
+	register  sync.Once
+	mu        sync.Mutex
+	status    string
+	crashes   int64
+	lastError error
+}
+
+// Run runs the rounds paced by the backoff until the context is done or a round fails with a fatal error. A round
+// returns true if it found work, so the next round starts immediately. The worker is reported by the health check
+// from the first run on.
+func (s *Supervisor) Run(ctx context.Context, backoff *Backoff, round func() (bool, error)) {
+	s.register.Do(func() {
+		supervisorsMu.Lock()
+		defer supervisorsMu.Unlock()
+		supervisors = append(supervisors, s)
+	})
+	s.setStatus(WorkerRunning)
+
+	busy := true
+	for backoff.Wait(ctx, busy) {
+		start := time.Now()
+		var err error
+		busy, err = s.runRound(ctx, round)
+		metrics.WorkerBatchDuration.WithLabelValues(s.Name).Observe(time.Since(start).Seconds())
+		if err == nil {
+			s.Restart.Reset()
+			s.setStatus(WorkerRunning)
+			continue
+		}
+
+		s.crashed(err)
+		if IsFatal(err) {
+			log.Errorf(ctx, err, "%s worker failed", s.Name)
+			s.setStatus(WorkerFailed)
+			return
+		}
+
+		log.Errorf(ctx, err, "%s worker round failed, restarting", s.Name)
+		s.setStatus(WorkerRestarting)
+		if !s.Restart.Wait(ctx, false) {
+			break
+		}
+
+		// the failed round is retried right after the restart delay
+		busy = true
+	}
+
+	s.setStatus(WorkerStopped)
+}
+
+// Health returns the current state of the worker.
+func (s *Supervisor) Health() WorkerHealth {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return WorkerHealth{Name: s.Name, Status: s.status, Crashes: s.crashes, LastError: s.lastError}
+}
+
+func (s *Supervisor) runRound(ctx context.Context, round func() (bool, error)) (busy bool, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			log.Printf(ctx, "%s worker panicked: %v\n%s", s.Name, r, debug.Stack())
+			busy = false
+			err = errors.Wrapf(ErrPanic, "%v", r)
+		}
+	}()
+
+	return round()
+}
+
+func (s *Supervisor) crashed(err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.crashes++
+	s.lastError = err
+	workerCrashes.Add(s.Name, 1)
+}
+
+func (s *Supervisor) setStatus(status string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.status = status
+}
+
+// NewSupervisor returns Supervisor instance restarting the failed rounds after a delay doubling with every
+// consecutive failure from the minimum up to the maximum.
+func NewSupervisor(name string, minRestartDelay time.Duration, maxRestartDelay time.Duration) *Supervisor {
+	return &Supervisor{
+		Name:    name,
+		Restart: NewBackoff(nil, minRestartDelay, maxRestartDelay),
+		status:  WorkerStopped,
+	}
+}
+
+// Health returns the state of every supervised worker started by the instance.
+func Health() []WorkerHealth {
+	supervisorsMu.Lock()
+	defer supervisorsMu.Unlock()
+
+	health := make([]WorkerHealth, 0, len(supervisors))
+	for _, s := range supervisors {
+		health = append(health, s.Health())
+	}
+
+	return health
+}
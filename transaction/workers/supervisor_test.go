@@ -0,0 +1,97 @@
+package workers_test
+
+import (
+	"context"
+	"errors"
+	"github.com/jackc/pgx/v5/pgconn"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"time"
+	"wallet/transaction/internal/domain/services"
+	"wallet/transaction/workers"
+)
+
+var _ = Describe("worker supervisor", func() {
+	var (
+		supervisor *workers.Supervisor
+		backoff    *workers.Backoff
+		rounds     int
+	)
+
+	BeforeEach(func() {
+		supervisor = workers.NewSupervisor("test", time.Millisecond, 10*time.Millisecond)
+		backoff = workers.NewBackoff(nil, time.Millisecond, time.Millisecond)
+		rounds = 0
+	})
+
+	When("a round panics", func() {
+		It("the round should be retried and the crash counted", func() {
+			supervisor.Run(context.Background(), backoff, func() (bool, error) {
+				rounds++
+				if rounds == 1 {
+					panic("boom")
+				}
+
+				return false, &pgconn.PgError{Code: "42P01"}
+			})
+
+			Expect(rounds).To(Equal(2))
+			health := supervisor.Health()
+			Expect(health.Crashes).To(Equal(int64(2)))
+			Expect(health.Status).To(Equal(workers.WorkerFailed))
+		})
+	})
+
+	When("a round fails with a retryable error", func() {
+		It("the round should be retried until the context is done", func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			supervisor.Run(ctx, backoff, func() (bool, error) {
+				rounds++
+				if rounds == 3 {
+					cancel()
+					return false, nil
+				}
+
+				return false, &pgconn.PgError{Code: "40P01"}
+			})
+
+			Expect(rounds).To(Equal(3))
+			health := supervisor.Health()
+			Expect(health.Crashes).To(Equal(int64(2)))
+			Expect(health.Status).To(Equal(workers.WorkerStopped))
+			Expect(workers.Health()).To(ContainElement(health))
+		})
+	})
+
+	When("a round fails with a fatal error", func() {
+		It("the worker should be stopped as failed", func() {
+			supervisor.Run(context.Background(), backoff, func() (bool, error) {
+				rounds++
+
+				return false, &pgconn.PgError{Code: "42P01"}
+			})
+
+			Expect(rounds).To(Equal(1))
+			health := supervisor.Health()
+			Expect(health.Crashes).To(Equal(int64(1)))
+			Expect(health.Status).To(Equal(workers.WorkerFailed))
+			Expect(health.LastError).To(HaveOccurred())
+		})
+	})
+
+	DescribeTable("error classification",
+		func(err error, fatal bool) {
+			Expect(workers.IsFatal(err)).To(Equal(fatal))
+		},
+		Entry("lost connection", &pgconn.PgError{Code: "08006"}, false),
+		Entry("deadlock", &pgconn.PgError{Code: "40P01"}, false),
+		Entry("undefined table", &pgconn.PgError{Code: "42P01"}, true),
+		Entry("invalid password", &pgconn.PgError{Code: "28P01"}, true),
+		Entry("check violation", &pgconn.PgError{Code: "23514"}, true),
+		Entry("not null violation", &pgconn.PgError{Code: "23502"}, true),
+		Entry("unique violation", &pgconn.PgError{Code: "23505"}, false),
+		Entry("unbalanced postings", services.ErrUnbalancedPostings, false),
+		Entry("panic", workers.ErrPanic, false),
+		Entry("unknown error", errors.New("unknown"), false),
+	)
+})
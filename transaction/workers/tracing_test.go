@@ -0,0 +1,75 @@
+package workers_test
+
+import (
+	"context"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+	"wallet/transaction/internal/domain/repositories"
+	"wallet/transaction/internal/infrastructure/tracing"
+	"wallet/transaction/workers"
+)
+
+var _ = Describe("balance worker tracing", func() {
+	var recorder *tracetest.SpanRecorder
+
+	BeforeEach(func() {
+		recorder = tracetest.NewSpanRecorder()
+		otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+		DeferCleanup(func() {
+			otel.SetTracerProvider(noop.NewTracerProvider())
+		})
+	})
+
+	When("a transaction created within a trace is processed", func() {
+		var requestSpan trace.SpanContext
+
+		BeforeEach(func() {
+			ctx, span := tracing.Tracer().Start(context.Background(), "POST /transaction")
+			requestSpan = span.SpanContext()
+			transaction := createTransaction(uuid.New(), 10)
+			transaction.TraceParent = tracing.TraceParent(ctx)
+			Expect(repositories.NewTransactionRepository(DB).Save(transaction)).To(Succeed())
+			span.End()
+
+			_, err := workers.NewBalanceWorker(DB, uuid.New()).Process(context.Background())
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("the processing span should start a new trace linked to the request", func() {
+			var processing sdktrace.ReadOnlySpan
+			for _, span := range recorder.Ended() {
+				if span.Name() == "process transaction" {
+					processing = span
+				}
+			}
+			Expect(processing).ToNot(BeNil())
+
+			Expect(processing.SpanContext().TraceID()).ToNot(Equal(requestSpan.TraceID()))
+			Expect(processing.Links()).To(HaveLen(1))
+			Expect(processing.Links()[0].SpanContext.SpanID()).To(Equal(requestSpan.SpanID()))
+		})
+
+		It("the queries of the processing should be traced within the processing span", func() {
+			var processing trace.SpanContext
+			queries := 0
+			for _, span := range recorder.Ended() {
+				if span.Name() == "process transaction" {
+					processing = span.SpanContext()
+				}
+			}
+			for _, span := range recorder.Ended() {
+				if span.Parent().SpanID() == processing.SpanID() {
+					queries++
+				}
+			}
+
+			Expect(queries).To(BeNumerically(">", 0))
+		})
+	})
+})